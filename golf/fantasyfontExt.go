@@ -0,0 +1,32 @@
+package golf
+
+// internalFontSheet is the extended font glyphs, see textRefExt
+var internalFontSheet = [0x6C0]byte{
+	0x55, 0x15, 0x0, 0x55, 0x55, 0x0, 0x55, 0x45, 0x0, 0x55, 0x55, 0x0, 0x41, 0x55, 0x0, 0x15, 0x55, 0x0, 0x45, 0x55, 0x0, 0x51, 0x51, 0x0, 0x15, 0x54, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x54, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55,
+	0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x5, 0x14, 0x0, 0x50, 0x5, 0x0, 0x54, 0x40, 0x0, 0x5, 0x55, 0x0, 0x54, 0x5, 0x0, 0x14, 0x50, 0x0, 0x55, 0x55, 0x0, 0x55, 0x51, 0x0, 0x54, 0x54, 0x0, 0x41, 0x14, 0x0, 0x50, 0x5,
+	0x0, 0x15, 0x50, 0x0, 0x5, 0x14, 0x0, 0x41, 0x5, 0x0, 0x14, 0x50, 0x0, 0x51, 0x14, 0x0, 0x51, 0x51, 0x0, 0x11, 0x45, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0,
+	0x51, 0x14, 0x0, 0x45, 0x51, 0x0, 0x15, 0x45, 0x0, 0x1, 0x14, 0x0, 0x50, 0x51, 0x0, 0x14, 0x45, 0x0, 0x45, 0x55, 0x0, 0x51, 0x1, 0x0, 0x55, 0x54, 0x0, 0x11, 0x11, 0x0, 0x45, 0x51, 0x0, 0x14, 0x45, 0x0, 0x51, 0x14, 0x0, 0x54, 0x41, 0x0, 0x55, 0x54, 0x0, 0x51, 0x14, 0x0, 0x51, 0x11, 0x0, 0x51, 0x50, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55,
+	0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x51, 0x14, 0x0, 0x45, 0x51, 0x0, 0x15, 0x45, 0x0, 0x51, 0x55, 0x0, 0x54, 0x5, 0x0, 0x14, 0x45, 0x0, 0x45, 0x55, 0x0, 0x51, 0x11, 0x0, 0x55, 0x54, 0x0, 0x11, 0x11, 0x0, 0x45, 0x51,
+	0x0, 0x14, 0x45, 0x0, 0x51, 0x14, 0x0, 0x55, 0x15, 0x0, 0x54, 0x54, 0x0, 0x51, 0x14, 0x0, 0x51, 0x11, 0x0, 0x51, 0x50, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0,
+	0x5, 0x14, 0x0, 0x50, 0x5, 0x0, 0x54, 0x40, 0x0, 0x5, 0x54, 0x0, 0x54, 0x55, 0x0, 0x14, 0x45, 0x0, 0x45, 0x55, 0x0, 0x51, 0x51, 0x0, 0x54, 0x51, 0x0, 0x11, 0x11, 0x0, 0x45, 0x5, 0x0, 0x15, 0x50, 0x0, 0x5, 0x14, 0x0, 0x55, 0x1, 0x0, 0x55, 0x41, 0x0, 0x5, 0x54, 0x0, 0x54, 0x45, 0x0, 0x14, 0x45, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55,
+	0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x5, 0x0, 0x55, 0x55, 0x0, 0x55, 0x15, 0x0, 0x54, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55,
+	0x0, 0x15, 0x55, 0x0, 0x55, 0x54, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0,
+	0x55, 0x55, 0x0, 0x55, 0x45, 0x0, 0x55, 0x51, 0x0, 0x5, 0x15, 0x0, 0x45, 0x5, 0x0, 0x54, 0x54, 0x0, 0x15, 0x55, 0x0, 0x50, 0x51, 0x0, 0x54, 0x51, 0x0, 0x51, 0x14, 0x0, 0x40, 0x45, 0x0, 0x55, 0x51, 0x0, 0x5, 0x15, 0x0, 0x45, 0x45, 0x0, 0x55, 0x51, 0x0, 0x51, 0x54, 0x0, 0x54, 0x15, 0x0, 0x55, 0x50, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55,
+	0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x51, 0x14, 0x0, 0x40, 0x5, 0x0, 0x55, 0x50, 0x0, 0x5, 0x55, 0x0, 0x50, 0x51, 0x0, 0x15, 0x40, 0x0, 0x1, 0x14, 0x0, 0x40, 0x1, 0x0, 0x14, 0x50, 0x0, 0x1, 0x15, 0x0, 0x45, 0x5,
+	0x0, 0x55, 0x50, 0x0, 0x5, 0x55, 0x0, 0x50, 0x51, 0x0, 0x14, 0x45, 0x0, 0x51, 0x54, 0x0, 0x40, 0x5, 0x0, 0x54, 0x40, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0,
+	0x51, 0x54, 0x0, 0x51, 0x51, 0x0, 0x14, 0x45, 0x0, 0x51, 0x14, 0x0, 0x45, 0x51, 0x0, 0x15, 0x50, 0x0, 0x1, 0x15, 0x0, 0x50, 0x1, 0x0, 0x55, 0x54, 0x0, 0x45, 0x15, 0x0, 0x44, 0x51, 0x0, 0x14, 0x45, 0x0, 0x51, 0x14, 0x0, 0x45, 0x51, 0x0, 0x14, 0x45, 0x0, 0x51, 0x14, 0x0, 0x45, 0x51, 0x0, 0x14, 0x45, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55,
+	0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x5, 0x54, 0x0, 0x54, 0x1, 0x0, 0x14, 0x40, 0x0, 0x1, 0x14, 0x0, 0x40, 0x5, 0x0, 0x14, 0x55, 0x0, 0x51, 0x15, 0x0, 0x55, 0x51, 0x0, 0x55, 0x54, 0x0, 0x45, 0x15, 0x0, 0x41, 0x51,
+	0x0, 0x14, 0x45, 0x0, 0x51, 0x14, 0x0, 0x45, 0x51, 0x0, 0x14, 0x45, 0x0, 0x51, 0x14, 0x0, 0x45, 0x51, 0x0, 0x14, 0x45, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0,
+	0x55, 0x14, 0x0, 0x40, 0x51, 0x0, 0x14, 0x45, 0x0, 0x51, 0x14, 0x0, 0x45, 0x15, 0x0, 0x15, 0x40, 0x0, 0x1, 0x14, 0x0, 0x40, 0x1, 0x0, 0x14, 0x50, 0x0, 0x1, 0x15, 0x0, 0x45, 0x5, 0x0, 0x55, 0x50, 0x0, 0x5, 0x55, 0x0, 0x50, 0x5, 0x0, 0x55, 0x50, 0x0, 0x5, 0x55, 0x0, 0x40, 0x5, 0x0, 0x54, 0x40, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55,
+	0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x5, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x45, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55,
+	0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0,
+	0x51, 0x54, 0x0, 0x55, 0x45, 0x0, 0x55, 0x51, 0x0, 0x5, 0x15, 0x0, 0x45, 0x15, 0x0, 0x15, 0x51, 0x0, 0x1, 0x54, 0x0, 0x54, 0x15, 0x0, 0x55, 0x50, 0x0, 0x51, 0x54, 0x0, 0x54, 0x15, 0x0, 0x15, 0x45, 0x0, 0x5, 0x55, 0x0, 0x51, 0x45, 0x0, 0x55, 0x54, 0x0, 0x51, 0x55, 0x0, 0x55, 0x45, 0x0, 0x15, 0x40, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55,
+	0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x5, 0x54, 0x0, 0x40, 0x5, 0x0, 0x55, 0x50, 0x0, 0x5, 0x55, 0x0, 0x50, 0x55, 0x0, 0x55, 0x55, 0x0, 0x1, 0x55, 0x0, 0x50, 0x5, 0x0, 0x55, 0x50, 0x0, 0x5, 0x15, 0x0, 0x45, 0x51,
+	0x0, 0x14, 0x45, 0x0, 0x51, 0x54, 0x0, 0x55, 0x55, 0x0, 0x55, 0x54, 0x0, 0x45, 0x55, 0x0, 0x55, 0x11, 0x0, 0x15, 0x45, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0,
+	0x51, 0x14, 0x0, 0x55, 0x1, 0x0, 0x14, 0x40, 0x0, 0x1, 0x14, 0x0, 0x40, 0x45, 0x0, 0x55, 0x54, 0x0, 0x51, 0x14, 0x0, 0x45, 0x51, 0x0, 0x14, 0x45, 0x0, 0x51, 0x14, 0x0, 0x45, 0x51, 0x0, 0x14, 0x45, 0x0, 0x11, 0x55, 0x0, 0x51, 0x45, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x44, 0x45, 0x0, 0x15, 0x45, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55,
+	0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x51, 0x14, 0x0, 0x55, 0x51, 0x0, 0x15, 0x55, 0x0, 0x51, 0x15, 0x0, 0x55, 0x45, 0x0, 0x55, 0x54, 0x0, 0x51, 0x14, 0x0, 0x45, 0x51, 0x0, 0x14, 0x45, 0x0, 0x51, 0x14, 0x0, 0x45, 0x51,
+	0x0, 0x14, 0x45, 0x0, 0x51, 0x54, 0x0, 0x54, 0x45, 0x0, 0x55, 0x55, 0x0, 0x55, 0x15, 0x0, 0x51, 0x55, 0x0, 0x15, 0x45, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0,
+	0x5, 0x54, 0x0, 0x40, 0x5, 0x0, 0x54, 0x40, 0x0, 0x5, 0x54, 0x0, 0x40, 0x45, 0x0, 0x55, 0x54, 0x0, 0x51, 0x54, 0x0, 0x50, 0x5, 0x0, 0x55, 0x50, 0x0, 0x5, 0x55, 0x0, 0x40, 0x5, 0x0, 0x54, 0x40, 0x0, 0x11, 0x15, 0x0, 0x45, 0x45, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x15, 0x40, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55,
+	0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x51, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55,
+	0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x50, 0x45, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0, 0x55, 0x55, 0x0,
+}
@@ -2,104 +2,215 @@ package golf
 
 import (
 	"math"
-	"syscall/js"
+	"math/rand"
+	"time"
 )
 
-// Engine Screen Width and Height
+// Engine Screen Width and Height in the default Mode192x192 screen mode.
+// Use ScreenSize to get the size of the screen in the engine's mode
 const (
 	ScreenHeight = 192
 	ScreenWidth  = 192
 )
 
+// Mode is a screen resolution mode
+type Mode byte
+
+// Screen resolution modes, every mode fits in the same
+// screen buffer so the rest of the memory map doesn't move
+const (
+	Mode192x192 = Mode(iota)
+	Mode96x96
+	Mode256x144
+)
+
+// modeSizes is the width and height of each screen mode
+var modeSizes = [...][2]int{
+	Mode192x192: {192, 192},
+	Mode96x96:   {96, 96},
+	Mode256x144: {256, 144},
+}
+
 // Engine is the golf engine
 type Engine struct {
-	RAM           *[0xFFFF]byte
-	screenBufHook js.Value
-	Draw          func()
-	Update        func()
+	RAM     *[0xFFFF]byte
+	Draw    func()
+	Update  func()
+	backend Backend
+
+	// fixed time step loop state
+	step, acc, last time.Duration
+	running         bool
+	frameSkip       bool
+
+	rng     *rand.Rand
+	replay  replayState
+	capture *capture
+	display []byte
+	states  [][]byte
+	blitter blitter
+	mode    Mode
+	fonts   []*font
+}
+
+// maxUpdates is the most updates that will be run for a single
+// backend frame. Any time beyond this is dropped so a long stall
+// (e.g. a background tab) slows the game down rather than freezing it
+const maxUpdates = 8
+
+// EOp additional options for creating the engine
+type EOp struct {
+	// Backend is the platform the engine runs on. By default this is the
+	// browser when built for WASM and the headless backend otherwise
+	Backend Backend
+
+	// FPS is the number of times Update is called each second.
+	// The default is 60, set it to 30 to run the game in 30fps mode
+	FPS int
+
+	// FrameSkip lets the engine skip calls to Draw when it falls behind.
+	// Update is still called once for every time step but Draw is only
+	// called once before the screen is shown. By default Draw is called
+	// after every Update
+	FrameSkip bool
+
+	// Mode is the screen resolution mode. The default is Mode192x192,
+	// Mode96x96 is a chunky low res mode and Mode256x144 is widescreen
+	Mode Mode
 }
 
 //go:generate ../generate/genTemplates packedTemplates.go templates golf
 
 // NewEngine creates a new golf engine
-func NewEngine(update func(), draw func()) *Engine {
-	ret := Engine{
-		RAM:    &[0xFFFF]byte{},
-		Draw:   draw,
-		Update: update,
+func NewEngine(update func(), draw func(), opts ...EOp) *Engine {
+	opt := EOp{}
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	if opt.Backend == nil {
+		opt.Backend = defaultBackend()
+	}
+	if opt.FPS <= 0 {
+		opt.FPS = 60
+	}
+	if int(opt.Mode) >= len(modeSizes) {
+		opt.Mode = Mode192x192
 	}
 
-	doc := js.Global().Get("document")
-	ret.initKeyListener(doc)
-	ret.initMouseListener(js.Global().Get("golfcanvas"))
+	ret := Engine{
+		RAM:       &[0xFFFF]byte{},
+		Draw:      draw,
+		Update:    update,
+		backend:   opt.Backend,
+		step:      time.Second / time.Duration(opt.FPS),
+		frameSkip: opt.FrameSkip,
+		rng:       newRng(time.Now().UnixNano()),
+		replay:    replayState{mismatch: -1},
+		display:   make([]byte, ScreenHeight*8),
+		mode:      opt.Mode,
+	}
 
-	ret.RClip() // Reset the cliping box
+	ret.ResetDrawTarget() // Reset the draw target and the cliping box
 
 	// Set internal resources
-	base := internalSpriteBase
+	base := InternalSpriteBase
 	for i := 0; i < 0x0900; i++ {
 		ret.RAM[i+base] = internalSpriteSheet[i]
 	}
+	copy(ret.RAM[InternalFontBase:], internalFontSheet[:])
 
-	ret.RAM[startAnim] = 255
+	ret.RAM[StartAnim] = 255
 	ret.PalA(0)
 	ret.PalB(1)
 
-	// Inject the nessisary JS
-	script := doc.Call("createElement", "script")
-	script.Set("innerHTML", string(drawTemplate[:]))
-	doc.Get("body").Call("appendChild", script)
-
-	// Hook into the injected js
-	ret.screenBufHook = js.Global().Get("screenBuff")
+	ret.backend.Init(&ret)
 
 	return &ret
 }
 
+// ScreenSize gets the width and height of the screen in pixels for the engine's screen mode
+func (e *Engine) ScreenSize() (w, h int) {
+	size := modeSizes[e.mode]
+	return size[0], size[1]
+}
+
 // Run starts the game engine running
 func (e *Engine) Run() {
-	var renderFrame js.Func
-
-	renderFrame = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
-		e.addFrame()
-
-		if e.Frames() < int(e.RAM[startAnim]) {
-			e.startupAnim()
-		} else {
-			e.Update()
-			e.Draw()
+	e.backend.Run(e.frame)
+}
 
-			e.drawMouse()
+// frame runs one update for every fixed time step that has passed
+// since the last frame and then presents the screen. If no time step
+// has passed yet the screen is left alone
+func (e *Engine) frame(now time.Duration) {
+	if !e.running {
+		// always run an update on the very first frame
+		e.last = now - e.step
+		e.running = true
+	}
+	e.acc += now - e.last
+	e.last = now
+	if e.acc > maxUpdates*e.step {
+		e.acc = maxUpdates * e.step
+	}
+	if e.acc < e.step {
+		return
+	}
 
-			e.tickKeyboard()
-			e.tickMouse()
+	skip := e.frameSkip && !e.replaying()
+	for e.acc >= e.step {
+		e.acc -= e.step
+		e.replayInput()
+		e.update()
+		if !skip {
+			e.draw()
+			e.replayScreen()
 		}
 
-		js.CopyBytesToJS(e.screenBufHook, e.RAM[:screenPalSet+1])
-		js.Global().Call("drawScreen")
-		js.Global().Call("requestAnimationFrame", renderFrame)
+		e.tickKeyboard()
+		e.tickMouse()
+	}
+	if skip {
+		e.draw()
+	}
 
-		return nil
-	})
+	e.displayPallets(e.display)
+	e.captureScreen()
+	e.backend.Present(e.RAM[:ScreenPalSet+1], e.display)
+}
 
-	done := make(chan struct{}, 0)
+// update runs a single logical frame of the game
+func (e *Engine) update() {
+	e.addFrame()
 
-	js.Global().Call("requestAnimationFrame", renderFrame)
-	<-done
+	if e.Frames() < int(e.RAM[StartAnim]) {
+		return
+	}
+	e.Update()
 }
 
-// Frames is the number of frames since the engine was started
+// draw draws the current frame to the screen buffer
+func (e *Engine) draw() {
+	if e.Frames() < int(e.RAM[StartAnim]) {
+		e.startupAnim()
+		return
+	}
+	e.Draw()
+	e.drawMouse()
+}
+
+// Frames is the number of updates since the engine was started
 func (e *Engine) Frames() int {
-	return toInt(e.RAM[frames:frames+3], false)
+	return toInt(e.RAM[FrameCount:FrameCount+3], false)
 }
 
 func (e *Engine) addFrame() {
-	f := toInt(e.RAM[frames:frames+3], false)
+	f := toInt(e.RAM[FrameCount:FrameCount+3], false)
 	f++
 	b := toBytes(f, 3, false)
-	e.RAM[frames] = b[0]
-	e.RAM[frames+1] = b[1]
-	e.RAM[frames+2] = b[2]
+	e.RAM[FrameCount] = b[0]
+	e.RAM[FrameCount+1] = b[1]
+	e.RAM[FrameCount+2] = b[2]
 }
 
 // DrawMouse sets the draw style
@@ -108,39 +219,59 @@ func (e *Engine) addFrame() {
 // 2 = hand
 // 3 = cross
 func (e *Engine) DrawMouse(style int) {
-	e.RAM[mouseBase] &= 0b00111111
-	e.RAM[mouseBase] |= byte(style << 6)
+	e.RAM[MouseBase] &= 0b00111111
+	e.RAM[MouseBase] |= byte(style << 6)
 }
 
 // drawMouse draws the mouse on the screen
 func (e *Engine) drawMouse() {
-	e.setActiveSpriteBuff(internalSpriteBase)
-
-	cursor := e.RAM[mouseBase] >> 6
+	// always draw the mouse to the screen, even if the game left
+	// a draw target, viewport or draw pallet set
+	e.PushState()
+	e.ResetDrawTarget()
+	e.RAM[ViewX], e.RAM[ViewY], e.RAM[ViewZoom] = 0, 0, 0
+	e.RPal()
+	e.setActiveSpriteBuff(InternalSpriteBase)
+
+	cursor := e.RAM[MouseBase] >> 6
 	opt := SOp{Fixed: true, TCol: Col7}
 
 	if cursor == 1 {
-		e.Spr(18, float64(e.RAM[mouseX]), float64(e.RAM[mouseY]), opt)
+		e.Spr(18, float64(e.RAM[MouseX]), float64(e.RAM[MouseY]), opt)
 	}
 	if cursor == 2 {
-		e.Spr(50, float64(e.RAM[mouseX]), float64(e.RAM[mouseY]), opt)
+		e.Spr(50, float64(e.RAM[MouseX]), float64(e.RAM[MouseY]), opt)
 	}
 	if cursor == 3 {
-		e.Spr(82, float64(e.RAM[mouseX]), float64(e.RAM[mouseY]), opt)
+		e.Spr(82, float64(e.RAM[MouseX]), float64(e.RAM[MouseY]), opt)
 	}
 
-	e.setActiveSpriteBuff(spriteBase)
+	e.PopState()
+	e.setActiveSpriteBuff(SpriteBase)
 }
 
 // Mouse returns the X, Y coords of the mouse
 func (e *Engine) Mouse() (int, int) {
-	return int(e.RAM[mouseX]), int(e.RAM[mouseY])
+	return int(e.RAM[MouseX]), int(e.RAM[MouseY])
 }
 
-// Cls fills the screen with col and resets TextL and TextR
+// Cls fills the screen (or the draw target) with col and resets TextL and TextR
 func (e *Engine) Cls(col Col) {
-	textLline, textRline = 0, 0
+	textLy, textRy = 0, 0
+
+	base, w, h := e.drawTarget()
+	if e.Peek16(FillPattern) != 0 {
+		// Cls fills the whole draw target, ignoring the clip and viewport
+		cv := e.canvas(col)
+		cv.left, cv.top, cv.right, cv.bottom = 0, 0, float64(w), float64(h)
+		cv.vx, cv.vy, cv.z = 0, 0, 1
+		for y := 0; y < h; y++ {
+			cv.span(0, w, float64(y))
+		}
+		return
+	}
 
+	col = e.drawCol(col)
 	c := col & 0b00000011
 	colBG := byte((c << 6) | (c << 4) | (c << 2) | c)
 	palBG := byte(0)
@@ -148,10 +279,10 @@ func (e *Engine) Cls(col Col) {
 		palBG = 0b11111111
 	}
 
-	for i := 0; i < screenPalSet; i++ {
-		e.RAM[i] = colBG
+	for i := 0; i < w*h/8*3; i++ {
+		e.RAM[base+i] = colBG
 		if (i+1)%3 == 0 {
-			e.RAM[i] = palBG
+			e.RAM[base+i] = palBG
 		}
 	}
 }
@@ -160,10 +291,10 @@ func (e *Engine) Cls(col Col) {
 func (e *Engine) Camera(x, y int) {
 	xb := toBytes(x, 2, true)
 	yb := toBytes(y, 2, true)
-	e.RAM[cameraX] = xb[0]
-	e.RAM[cameraX+1] = xb[1]
-	e.RAM[cameraY] = yb[0]
-	e.RAM[cameraY+1] = yb[1]
+	e.RAM[CameraX] = xb[0]
+	e.RAM[CameraX+1] = xb[1]
+	e.RAM[CameraY] = yb[0]
+	e.RAM[CameraY+1] = yb[1]
 }
 
 // Rect draws a rectangle border on the screen
@@ -173,16 +304,17 @@ func (e *Engine) Rect(x, y, w, h float64, col Col, fixed ...bool) {
 		f = fixed[0]
 	}
 	if !f {
-		x -= toFloat(e.RAM[cameraX:cameraX+2], true)
-		y -= toFloat(e.RAM[cameraY:cameraY+2], true)
+		x -= toFloat(e.RAM[CameraX:CameraX+2], true)
+		y -= toFloat(e.RAM[CameraY:CameraY+2], true)
 	}
+	cv := e.canvas(col)
 	for r := 0.0; r < w; r++ {
-		e.Pset(x+r, y, col)
-		e.Pset(x+r, y+(h-1), col)
+		cv.pset(x+r, y, cv.col)
+		cv.pset(x+r, y+(h-1), cv.col)
 	}
 	for c := 0.0; c < h; c++ {
-		e.Pset(x, y+c, col)
-		e.Pset(x+(w-1), y+(c), col)
+		cv.pset(x, y+c, cv.col)
+		cv.pset(x+(w-1), y+(c), cv.col)
 	}
 }
 
@@ -193,41 +325,56 @@ func (e *Engine) RectFill(x, y, w, h float64, col Col, fixed ...bool) {
 		f = fixed[0]
 	}
 	if !f {
-		x -= toFloat(e.RAM[cameraX:cameraX+2], true)
-		y -= toFloat(e.RAM[cameraY:cameraY+2], true)
+		x -= toFloat(e.RAM[CameraX:CameraX+2], true)
+		y -= toFloat(e.RAM[CameraY:CameraY+2], true)
 	}
-	for r := 0.0; r < w; r++ {
-		for c := 0.0; c < h; c++ {
-			e.Pset(x+r, y+c, col)
-		}
+	if w <= 0 {
+		return
+	}
+	cv := e.canvas(col)
+	n := int(math.Ceil(w))
+	for c := 0.0; c < h; c++ {
+		cv.span(x, n, y+c)
 	}
 }
 
-// Line draws a colored line
+// Line draws a colored line using Bresenham's algorithm.
+// The last point (x2, y2) is not drawn
 func (e *Engine) Line(x1, y1, x2, y2 float64, col Col, fixed ...bool) {
 	f := false
 	if len(fixed) > 0 {
 		f = fixed[0]
 	}
 	if !f {
-		x1 -= toFloat(e.RAM[cameraX:cameraX+2], true)
-		x2 -= toFloat(e.RAM[cameraX:cameraX+2], true)
-		y1 -= toFloat(e.RAM[cameraY:cameraY+2], true)
-		y2 -= toFloat(e.RAM[cameraY:cameraY+2], true)
-	}
-	dx, dy := x2-x1, y2-y1
-	step := math.Abs(dy)
-	if math.Abs(dx) >= math.Abs(dy) {
-		step = math.Abs(dx)
-	}
-
-	dx = dx / step
-	dy = dy / step
-	x, y := x1, y1
-	for i := 0.0; i < step; i++ {
-		e.Pset(x, y, col)
-		x = x + dx
-		y = y + dy
+		cx := toFloat(e.RAM[CameraX:CameraX+2], true)
+		cy := toFloat(e.RAM[CameraY:CameraY+2], true)
+		x1, x2 = x1-cx, x2-cx
+		y1, y2 = y1-cy, y2-cy
+	}
+	cv := e.canvas(col)
+
+	x, y := int(math.Floor(x1)), int(math.Floor(y1))
+	ex, ey := int(math.Floor(x2)), int(math.Floor(y2))
+	dx, dy := ex-x, ey-y
+	sx, sy := 1, 1
+	if dx < 0 {
+		dx, sx = -dx, -1
+	}
+	if dy < 0 {
+		dy, sy = -dy, -1
+	}
+	err := dx - dy
+	for x != ex || y != ey {
+		cv.pset(float64(x), float64(y), cv.col)
+		e2 := 2 * err
+		if e2 > -dy {
+			err -= dy
+			x += sx
+		}
+		if e2 < dx {
+			err += dx
+			y += sy
+		}
 	}
 }
 
@@ -250,60 +397,161 @@ func (e *Engine) CircFill(xc, yc, r float64, col Col, fixed ...bool) {
 }
 
 // drawCirc8 draws 8 points on a circle
-func (e *Engine) drawCirc8(xc, yc, x, y float64, c Col, filled bool, fixed bool) {
+func (e *Engine) drawCirc8(cv *canvas, xc, yc, x, y float64, filled bool) {
+	pset := func(x, y float64) { cv.pset(x, y, cv.col) }
 	if filled {
-		e.Line(xc+x, yc+y, xc+x, yc-y, c, fixed)
-		e.Line(xc-x, yc+y, xc-x, yc-y, c, fixed)
-		e.Line(xc+y, yc+x, xc+y, yc-x, c, fixed)
-		e.Line(xc-y, yc+x, xc-y, yc-x, c, fixed)
-	}
-	if !fixed {
-		xc -= toFloat(e.RAM[cameraX:cameraX+2], true)
-		yc -= toFloat(e.RAM[cameraY:cameraY+2], true)
+		pset = cv.fpset
+		for i := 0.0; i < 2*y; i++ {
+			pset(xc+x, yc+y-i)
+			pset(xc-x, yc+y-i)
+		}
+		for i := 0.0; i < 2*x; i++ {
+			pset(xc+y, yc+x-i)
+			pset(xc-y, yc+x-i)
+		}
 	}
-	e.Pset(xc+x, yc+y, c)
-	e.Pset(xc-x, yc+y, c)
-	e.Pset(xc+x, yc-y, c)
-	e.Pset(xc-x, yc-y, c)
-	e.Pset(xc+y, yc+x, c)
-	e.Pset(xc-y, yc+x, c)
-	e.Pset(xc+y, yc-x, c)
-	e.Pset(xc-y, yc-x, c)
+	pset(xc+x, yc+y)
+	pset(xc-x, yc+y)
+	pset(xc+x, yc-y)
+	pset(xc-x, yc-y)
+	pset(xc+y, yc+x)
+	pset(xc-y, yc+x)
+	pset(xc+y, yc-x)
+	pset(xc-y, yc-x)
 }
 
 func (e *Engine) circ(xc, yc, r float64, c Col, filled bool, fixed bool) {
 	if r == 0 {
 		return
 	}
+	if !fixed {
+		xc -= toFloat(e.RAM[CameraX:CameraX+2], true)
+		yc -= toFloat(e.RAM[CameraY:CameraY+2], true)
+	}
+	cv := e.canvas(c)
+
+	// the half height of each column of a filled circle
+	var heights []int
+	if filled && r == math.Floor(r) && r > 0 {
+		heights = make([]int, int(r)+1)
+		for i := range heights {
+			heights[i] = -1
+		}
+	}
+
 	x, y := 0.0, r
 	d := 3 - 2*r
-	e.drawCirc8(xc, yc, x, y, c, filled, fixed)
-	for y >= x {
-		x++
-		if d > 0 {
-			y--
-			d = d + 4*(x-y) + 10
-		} else {
-			d = d + 4*x + 6
+	for first := true; first || y >= x; first = false {
+		if !first {
+			x++
+			if d > 0 {
+				y--
+				d = d + 4*(x-y) + 10
+			} else {
+				d = d + 4*x + 6
+			}
+		}
+		if heights == nil {
+			e.drawCirc8(&cv, xc, yc, x, y, filled)
+			continue
+		}
+		for _, col := range [2][2]float64{{x, y}, {y, x}} {
+			if col[0] >= 0 && col[0] <= r && int(col[1]) > heights[int(col[0])] {
+				heights[int(col[0])] = int(col[1])
+			}
+		}
+	}
+	if heights == nil {
+		return
+	}
+
+	// fill each row with the columns that reach it
+	for dy := 0; dy <= int(r); dy++ {
+		for x1 := -int(r); x1 <= int(r); x1++ {
+			if heights[abs(x1)] < dy {
+				continue
+			}
+			x2 := x1
+			for x2+1 <= int(r) && heights[abs(x2+1)] >= dy {
+				x2++
+			}
+			cv.span(xc+float64(x1), x2-x1+1, yc+float64(dy))
+			if dy > 0 {
+				cv.span(xc+float64(x1), x2-x1+1, yc-float64(dy))
+			}
+			x1 = x2
 		}
-		e.drawCirc8(xc, yc, x, y, c, filled, fixed)
+	}
+}
+
+// FillP sets the 4x4 fill pattern used by Cls and the filled shapes.
+// The top bit of pattern is the top left pixel of the pattern and the
+// bits go left to right, top to bottom. Pixels with their bit set are drawn
+// with alt, or are transparent if alt is not set. A pattern of 0 turns
+// fill patterns off, e.g. FillP(0b1010010110100101, Col0) is a checkerboard
+func (e *Engine) FillP(pattern uint16, alt ...Col) {
+	e.Poke16(FillPattern, int(pattern))
+	e.RAM[FillCol] = 0
+	if len(alt) > 0 {
+		e.RAM[FillCol] = byte(alt[0])
 	}
 }
 
 // Clip clips all functions that draw to the screen
-func (e *Engine) Clip(x, y, w, h int) {
-	e.RAM[clipX] = byte(x)
-	e.RAM[clipY] = byte(y)
-	e.RAM[clipW] = byte(w)
-	e.RAM[clipH] = byte(h)
+// If intersect is true the new clip is the overlap of x, y, w, h and the current clip,
+// this lets nested UI code limit drawing without undoing the clip it was given
+func (e *Engine) Clip(x, y, w, h int, intersect ...bool) {
+	if len(intersect) > 0 && intersect[0] {
+		cx, cy, cw, ch := e.clipRect()
+		x2, y2 := x+w, y+h
+		if x < cx {
+			x = cx
+		}
+		if y < cy {
+			y = cy
+		}
+		if x2 > cx+cw {
+			x2 = cx + cw
+		}
+		if y2 > cy+ch {
+			y2 = cy + ch
+		}
+		w, h = x2-x, y2-y
+	}
+	if x < 0 {
+		w, x = w+x, 0
+	}
+	if y < 0 {
+		h, y = h+y, 0
+	}
+	if w < 0 {
+		w = 0
+	}
+	if h < 0 {
+		h = 0
+	}
+
+	e.RAM[ClipX] = byte(x)
+	e.RAM[ClipY] = byte(y)
+	e.RAM[ClipW] = byte(w)
+	e.RAM[ClipH] = byte(h)
+
+	// the 9th bit of the width and height so the clip can cover a 256 pixel target
+	e.RAM[ClipHi] = byte(w>>8&1) | byte(h>>8&1)<<1
 }
 
-// RClip resets the screen cliping
+// RClip resets the screen cliping so the whole screen (or draw target) can be drawn to
 func (e *Engine) RClip() {
-	e.RAM[clipX] = 0
-	e.RAM[clipY] = 0
-	e.RAM[clipW] = 192
-	e.RAM[clipH] = 192
+	_, w, h := e.drawTarget()
+	e.Clip(0, 0, w, h)
+}
+
+// clipRect gets the current clipping rect
+func (e *Engine) clipRect() (x, y, w, h int) {
+	x, y = int(e.RAM[ClipX]), int(e.RAM[ClipY])
+	w = int(e.RAM[ClipW]) | int(e.RAM[ClipHi]&1)<<8
+	h = int(e.RAM[ClipH]) | int(e.RAM[ClipHi]>>1&1)<<8
+	return x, y, w, h
 }
 
 // sets a pixel in abitrary memory
@@ -325,13 +573,38 @@ func (e *Engine) pset(x, y float64, col Col, buffBase, pxlWidth int) {
 	e.RAM[buffBase+pIndex] |= pallet
 }
 
-// Pset sets a pixel on the screen
+// Pset sets a pixel on the screen (or the draw target)
+// col is mapped through the draw pallet set with Pal
 func (e *Engine) Pset(x, y float64, col Col) {
-	if x < float64(e.RAM[clipX]) || x >= float64(e.RAM[clipX]+e.RAM[clipW]) ||
-		y < float64(e.RAM[clipY]) || y >= float64(e.RAM[clipY]+e.RAM[clipH]) {
+	col = e.drawCol(col)
+	vx, vy, z := e.view()
+	if z == 1 {
+		e.tpset(x+vx, y+vy, col)
 		return
 	}
-	e.pset(x, y, col, 0, 192)
+
+	// draw a zoom x zoom block of pixels
+	x, y = math.Floor(x)*z+vx, math.Floor(y)*z+vy
+	for j := 0.0; j < z; j++ {
+		for i := 0.0; i < z; i++ {
+			e.tpset(x+i, y+j, col)
+		}
+	}
+}
+
+// tpset sets a pixel on the draw target if it's inside the clipping rect.
+// x and y are draw target coordinates so the viewport is not used
+func (e *Engine) tpset(x, y float64, col Col) {
+	cx, cy, cw, ch := e.clipRect()
+	if x < float64(cx) || x >= float64(cx+cw) ||
+		y < float64(cy) || y >= float64(cy+ch) {
+		return
+	}
+	base, w, h := e.drawTarget()
+	if x < 0 || x >= float64(w) || y < 0 || y >= float64(h) {
+		return
+	}
+	e.pset(x, y, col, base, w)
 }
 
 // pget gets a pixel from abitrary memory
@@ -350,29 +623,36 @@ func (e *Engine) pget(x, y float64, buffBase, pxlWidth int) Col {
 	return Col((color | (pallet << 2)) | 0b10000000)
 }
 
-// Pget gets the color of a pixel on the screen
+// Pget gets the color of a pixel on the screen (or the draw target)
 func (e *Engine) Pget(x, y float64) Col {
-	if x < 0 || x > 192 || y < 0 || y >= 192 {
+	vx, vy, z := e.view()
+	if z > 1 {
+		x, y = math.Floor(x)*z, math.Floor(y)*z
+	}
+	x, y = x+vx, y+vy
+
+	base, w, h := e.drawTarget()
+	if x < 0 || x >= float64(w) || y < 0 || y >= float64(h) {
 		return Col0
 	}
-	return e.pget(x, y, 0, 192)
+	return e.pget(x, y, base, w)
 }
 
 // PalA sets pallet A
 func (e *Engine) PalA(pallet Pal) {
-	e.RAM[screenPalSet] &= 0b00001111
-	e.RAM[screenPalSet] |= byte(pallet << 4)
+	e.RAM[ScreenPalSet] &= 0b00001111
+	e.RAM[ScreenPalSet] |= byte(pallet << 4)
 }
 
 // PalB sets pallet B
 func (e *Engine) PalB(pallet Pal) {
-	e.RAM[screenPalSet] &= 0b11110000
-	e.RAM[screenPalSet] |= byte(pallet)
+	e.RAM[ScreenPalSet] &= 0b11110000
+	e.RAM[ScreenPalSet] |= byte(pallet)
 }
 
 // PalGet gets the currently set pallets
 func (e *Engine) PalGet() (Pal, Pal) {
-	return Pal(e.RAM[screenPalSet] >> 4), Pal(e.RAM[screenPalSet] & 0b00001111)
+	return Pal(e.RAM[ScreenPalSet] >> 4), Pal(e.RAM[ScreenPalSet] & 0b00001111)
 }
 
 // Col is a screen color
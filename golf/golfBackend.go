@@ -0,0 +1,31 @@
+package golf
+
+import "time"
+
+// Backend is the platform the golf engine runs on.
+// It schedules frames, shows the screen buffer, passes
+// input events to the engine and stores cart data
+type Backend interface {
+	// Init is called once by NewEngine. Input events should be
+	// passed to the engine using PressKey, ReleaseKey, MoveMouse,
+	// PressMouse and ReleaseMouse
+	Init(e *Engine)
+
+	// Run calls frame once for every frame the backend displays until
+	// the backend stops. now is the time the frame started, it's used
+	// by the engine to decide how many updates to run
+	Run(frame func(now time.Duration))
+
+	// Present shows the screen buffer, this is the packed
+	// screen data followed by the screen pallet byte. pal is
+	// the display pallet, it holds 8 colors for each scanline.
+	// Each color is the index of a shade in the 64 color GoLF
+	// pallet (pallet*4 + shade)
+	Present(screen, pal []byte)
+
+	// Store saves the cart data under the given name
+	Store(name string, data []byte) error
+
+	// Fetch gets the cart data saved under the given name
+	Fetch(name string) ([]byte, bool)
+}
@@ -0,0 +1,132 @@
+package golf
+
+import "math"
+
+// blitter holds the lookup tables used to draw a sprite. It's
+// kept on the engine so drawing sprites doesn't allocate
+type blitter struct {
+	cols []blitCol
+	rows []blitRow
+}
+
+// blitCol is a column of the draw target and the sprite sheet
+// column that is drawn there. src and dst are pixel x coordinates
+type blitCol struct {
+	src, dst int
+	// byte offsets and bit shifts of the shade and pallet bits
+	sIndex, sPIndex, dIndex, dPIndex int
+	sShift, sPShift, dShift, dPShift uint
+}
+
+// newBlitCol works out the byte offsets and bit shifts for the src and dst columns
+func newBlitCol(src, dst int) blitCol {
+	return blitCol{
+		src: src, dst: dst,
+		sIndex: src/8*3 + (src/4)%2, sPIndex: src/8*3 + 2,
+		dIndex: dst/8*3 + (dst/4)%2, dPIndex: dst/8*3 + 2,
+		sShift: uint(src%4) * 2, sPShift: uint(src % 8),
+		dShift: uint(dst%4) * 2, dPShift: uint(dst % 8),
+	}
+}
+
+// blitRow is a row of the draw target and the sprite sheet row
+// that is drawn there, both are byte offsets from the buffer base
+type blitRow struct {
+	src, dst int
+}
+
+// blitAxis finds the draw target pixel for each pixel of a sprite
+// row or column using the same rules as Pset. Sprite pixel p is
+// scaled to cover [int(p*scale), int((p+1)*scale)) and d is the
+// camera adjusted position of the sprite. add is called for every
+// target pixel that is inside the clipping rect and the draw target
+func blitAxis(n int, d, scale float64, flip bool, view, zoom float64, clip, clipL, size int, add func(src, dst int)) {
+	f := 0
+	if flip {
+		f = int(float64(n) * scale)
+	}
+	inside := func(p float64) bool {
+		return p >= float64(clip) && p < float64(clip+clipL) && p >= 0 && p < float64(size)
+	}
+	for p := 0; p < n; p++ {
+		for s := int(float64(p) * scale); s < int(float64(p+1)*scale); s++ {
+			pos := d + math.Abs(float64(f-s))
+			if zoom == 1 {
+				if inside(pos + view) {
+					add(p, int(pos+view))
+				}
+				continue
+			}
+			pos = math.Floor(pos)*zoom + view
+			for i := 0.0; i < zoom; i++ {
+				if inside(pos + i) {
+					add(p, int(pos+i))
+				}
+			}
+		}
+	}
+}
+
+// blit draws the sw x sh rect at sx, sy of the active sprite buffer to the
+// draw target. dx, dy is the camera adjusted position of the rect. The camera,
+// clip, viewport and colors are worked out once so each pixel is just a
+// couple of table lookups. Pixels are copied a byte at a time when they line up
+func (e *Engine) blit(sx, sy, sw, sh int, dx, dy float64, opt SOp) {
+	buffBase := toInt(e.RAM[ActiveSpriteBuff:ActiveSpriteBuff+2], false)
+	base, w, h := e.drawTarget()
+	cx, cy, cw, ch := e.clipRect()
+	vx, vy, z := e.view()
+
+	// the color each sprite color is drawn as
+	cols, clear := [8]byte{}, [8]bool{}
+	copyable := z == 1 && opt.SW == 1 && opt.SH == 1 && !opt.FH && !opt.FV
+	for i := range cols {
+		pxl := Col(i) | 0b10000000
+		clear[i] = e.transparent(pxl, opt.TCol, buffBase)
+		cols[i] = byte(e.drawCol(subPixels(opt.PFrom, opt.PTo, pxl)))
+		copyable = copyable && !clear[i] && cols[i] == byte(pxl)
+	}
+
+	b := &e.blitter
+	b.cols, b.rows = b.cols[:0], b.rows[:0]
+	// columns past the edge of the sprite sheet wrap onto the next row
+	blitAxis(sw, dx, opt.SW, opt.FH, vx, z, cx, cw, w, func(src, dst int) {
+		if sx+src >= 0 {
+			b.cols = append(b.cols, newBlitCol(sx+src, dst))
+		}
+	})
+	rowLen := (sx+sw)/8*3 + 3
+	blitAxis(sh, dy, opt.SH, opt.FV, vy, z, cy, ch, h, func(src, dst int) {
+		row := (sy + src) * 256 / 8 * 3
+		if sy+src >= 0 && e.inRAM(buffBase+row, rowLen) {
+			b.rows = append(b.rows, blitRow{src: buffBase + row, dst: base + dst*w/8*3})
+		}
+	})
+
+	ram := e.RAM
+	for _, r := range b.rows {
+		for i := 0; i < len(b.cols); i++ {
+			c := b.cols[i]
+
+			// copy 8 pixels at once when both groups are lined up
+			if copyable && c.src%8 == 0 && c.dst%8 == 0 && i+7 < len(b.cols) && b.cols[i+7].dst == c.dst+7 {
+				copy(ram[r.dst+c.dst/8*3:r.dst+c.dst/8*3+3], ram[r.src+c.src/8*3:r.src+c.src/8*3+3])
+				i += 7
+				continue
+			}
+
+			shade := ram[r.src+c.sIndex] >> c.sShift & 0b00000011
+			pallet := ram[r.src+c.sPIndex] >> c.sPShift & 0b00000001
+			pxl := shade | pallet<<2
+			if clear[pxl] {
+				continue
+			}
+
+			col := cols[pxl]
+			ram[r.dst+c.dIndex] &= (0b00000011 << c.dShift) ^ 0b11111111
+			ram[r.dst+c.dIndex] |= (col & 0b00000011) << c.dShift
+			ram[r.dst+c.dPIndex] &= (0b00000001 << c.dPShift) ^ 0b11111111
+			ram[r.dst+c.dPIndex] |= (col & 0b00000100) >> 2 << c.dPShift
+		}
+	}
+}
@@ -0,0 +1,266 @@
+package golf
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// pixelSSpr is SSpr drawn one pixel at a time with pget and Pset,
+// the way sprites were drawn before the blitter. The blitter must match it
+func pixelSSpr(e *Engine, sx, sy, sw, sh int, dx, dy float64, opt SOp) {
+	if !opt.Fixed {
+		dx -= toFloat(e.RAM[CameraX:CameraX+2], true)
+		dy -= toFloat(e.RAM[CameraY:CameraY+2], true)
+	}
+	if opt.SH == 0 {
+		opt.SH = 1
+	}
+	if opt.SW == 0 {
+		opt.SW = 1
+	}
+	buffBase := toInt(e.RAM[ActiveSpriteBuff:ActiveSpriteBuff+2], false)
+
+	for x := 0; x < sw; x++ {
+		for y := 0; y < sh; y++ {
+			pxl := e.pget(float64(sx+x), float64(sy+y), buffBase, 256)
+			if e.transparent(pxl, opt.TCol, buffBase) {
+				continue
+			}
+			pxl = subPixels(opt.PFrom, opt.PTo, pxl)
+			fx, fy := 0, 0
+			if opt.FH {
+				fx = int(float64(sw) * opt.SW)
+			}
+			if opt.FV {
+				fy = int(float64(sh) * opt.SH)
+			}
+			for scaleX := int(float64(x) * opt.SW); scaleX < int(float64(x+1)*opt.SW); scaleX++ {
+				for scaleY := int(float64(y) * opt.SH); scaleY < int(float64(y+1)*opt.SH); scaleY++ {
+					e.Pset(dx+math.Abs(float64(fx-scaleX)), dy+math.Abs(float64(fy-scaleY)), pxl)
+				}
+			}
+		}
+	}
+}
+
+// pixelMap is Map drawn with pixelSSpr
+func pixelMap(e *Engine, mx, my, mw, mh int, dx, dy float64, opt SOp) {
+	if opt.W == 0 {
+		opt.W = 1
+	}
+	if opt.H == 0 {
+		opt.H = 1
+	}
+	if opt.SH == 0 {
+		opt.SH = 1
+	}
+	if opt.SW == 0 {
+		opt.SW = 1
+	}
+	for x := 0; x < mw; x++ {
+		sprX := int(float64(int(dx)+x*8*opt.W) * roundPxl(opt.SW, float64(8*opt.W)))
+		for y := 0; y < mh; y++ {
+			sprY := int(float64(int(dy)+y*8*opt.H) * roundPxl(opt.SH, float64(8*opt.H)))
+			s := e.Mget(x+mx, y+my)
+			if s == 0 {
+				continue
+			}
+			pixelSSpr(e, s%32*8, s/32*8, opt.W*8, opt.H*8, float64(sprX), float64(sprY), opt)
+		}
+	}
+}
+
+// newBlitEngine creates an engine with a random sprite sheet and map
+func newBlitEngine(seed int64) *Engine {
+	r := rand.New(rand.NewSource(seed))
+	e := newTestEngine(NewHeadless())
+	r.Read(e.RAM[SpriteBase : SpriteBase+0x3000])
+	for x := 0; x < 64; x++ {
+		for y := 0; y < 64; y++ {
+			e.Mset(x, y, r.Intn(256))
+		}
+	}
+	e.setActiveSpriteBuff(SpriteBase)
+	return e
+}
+
+// randomSOp picks random sprite options
+func randomSOp(r *rand.Rand) SOp {
+	scales := []float64{1, 1, 2, 3, 0.5, 1.5}
+	opt := SOp{
+		FH: r.Intn(2) == 0, FV: r.Intn(2) == 0,
+		SW: scales[r.Intn(len(scales))], SH: scales[r.Intn(len(scales))],
+		Fixed: r.Intn(4) == 0,
+		W:     1 + r.Intn(2), H: 1 + r.Intn(2),
+	}
+	if r.Intn(2) == 0 {
+		opt.TCol = Col(0x80 | r.Intn(8))
+	}
+	if r.Intn(3) == 0 {
+		opt.PFrom = []Col{Col(0x80 | r.Intn(8))}
+		opt.PTo = []Col{Col(0x80 | r.Intn(8))}
+	}
+	return opt
+}
+
+// randomDrawState sets a random camera, clip, viewport and draw pallet.
+// If plain is set there is no viewport or draw pallet so sprites can be copied a byte at a time.
+// If fill is set there is also a random fill pattern
+func randomDrawState(r *rand.Rand, e *Engine, plain, fill bool) {
+	e.FillP(0)
+	e.Cls(Col(0x80 | r.Intn(8)))
+	e.RPal()
+	e.RViewport()
+	switch r.Intn(3) {
+	case 0:
+		e.Clip(r.Intn(100), r.Intn(100), r.Intn(150), r.Intn(150))
+	case 1:
+		if plain {
+			break
+		}
+		e.Viewport(r.Intn(100), r.Intn(100), r.Intn(150), r.Intn(150), r.Intn(40)-20, r.Intn(40)-20, 1+r.Intn(3))
+	}
+	e.Camera(r.Intn(60)-30, r.Intn(60)-30)
+	if plain {
+		return
+	}
+	if r.Intn(2) == 0 {
+		e.Pal(Col(0x80|r.Intn(8)), Col(0x80|r.Intn(8)))
+	}
+	if r.Intn(2) == 0 {
+		e.Palt(Col(0x80|r.Intn(8)), true)
+	}
+	if !fill {
+		return
+	}
+	switch r.Intn(3) {
+	case 0:
+		e.FillP(uint16(r.Intn(0x10000)))
+	case 1:
+		e.FillP(uint16(r.Intn(0x10000)), Col(0x80|r.Intn(8)))
+	}
+}
+
+// compareDraws runs draw and the per pixel reference from the
+// same starting RAM and fails if the screens are different
+func compareDraws(t *testing.T, e *Engine, name string, draw, pixel func()) {
+	t.Helper()
+	start := *e.RAM
+	draw()
+	got := append([]byte{}, e.RAM[:ScreenPalSet]...)
+	*e.RAM = start
+	pixel()
+	if !bytes.Equal(got, e.RAM[:ScreenPalSet]) {
+		t.Fatalf("%s: the draw doesn't match the per pixel draw", name)
+	}
+}
+
+func TestBlitSSpr(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	e := newBlitEngine(1)
+	for i := 0; i < 2000; i++ {
+		// every 4th case lines the sprite up with the packed bytes
+		plain := i%4 == 0
+		randomDrawState(r, e, plain, false)
+		opt := randomSOp(r)
+		sx, sy := r.Intn(256), r.Intn(128)
+		sw, sh := r.Intn(40), r.Intn(40)
+		dx, dy := float64(r.Intn(240)-40), float64(r.Intn(240)-40)
+		if plain {
+			opt = SOp{Fixed: true}
+			sx, dx = sx/8*8, math.Floor(dx/8)*8
+		} else if r.Intn(4) == 0 {
+			dx, dy = dx+r.Float64(), dy+r.Float64()
+		}
+		name := fmt.Sprintf("case %d SSpr(%d, %d, %d, %d, %v, %v, %+v)", i, sx, sy, sw, sh, dx, dy, opt)
+		compareDraws(t, e, name,
+			func() { e.SSpr(sx, sy, sw, sh, dx, dy, opt) },
+			func() { pixelSSpr(e, sx, sy, sw, sh, dx, dy, opt) },
+		)
+	}
+}
+
+func TestBlitMap(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	e := newBlitEngine(2)
+	for i := 0; i < 300; i++ {
+		plain := i%4 == 0
+		randomDrawState(r, e, plain, false)
+		opt := randomSOp(r)
+		mx, my := r.Intn(40), r.Intn(40)
+		mw, mh := r.Intn(25), r.Intn(25)
+		dx, dy := float64(r.Intn(80)-40), float64(r.Intn(80)-40)
+		if plain {
+			opt = SOp{Fixed: true}
+			dx = math.Floor(dx/8) * 8
+		}
+		name := fmt.Sprintf("case %d Map(%d, %d, %d, %d, %v, %v, %+v)", i, mx, my, mw, mh, dx, dy, opt)
+		compareDraws(t, e, name,
+			func() { e.Map(mx, my, mw, mh, dx, dy, opt) },
+			func() { pixelMap(e, mx, my, mw, mh, dx, dy, opt) },
+		)
+	}
+}
+
+// sprBenchmarks are the SSpr cases that are benchmarked
+var sprBenchmarks = []struct {
+	name           string
+	sx, sy, sw, sh int
+	opt            SOp
+}{
+	{"8x8", 8, 8, 8, 8, SOp{}},
+	{"fullscreen", 0, 0, 192, 128, SOp{}},
+	{"fullscreen-tcol", 0, 0, 192, 128, SOp{TCol: Col0}},
+	{"scaled", 0, 0, 64, 64, SOp{SW: 3, SH: 3}},
+	{"flipped", 0, 0, 128, 128, SOp{FH: true, FV: true}},
+}
+
+func BenchmarkSSpr(b *testing.B) {
+	for _, bm := range sprBenchmarks {
+		bm := bm
+		b.Run(bm.name+"/blit", func(b *testing.B) {
+			e := newBlitEngine(1)
+			for i := 0; i < b.N; i++ {
+				e.SSpr(bm.sx, bm.sy, bm.sw, bm.sh, 0, 0, bm.opt)
+			}
+		})
+		b.Run(bm.name+"/pixel", func(b *testing.B) {
+			e := newBlitEngine(1)
+			for i := 0; i < b.N; i++ {
+				pixelSSpr(e, bm.sx, bm.sy, bm.sw, bm.sh, 0, 0, bm.opt)
+			}
+		})
+	}
+}
+
+// mapBenchmarks are the Map cases that are benchmarked
+var mapBenchmarks = []struct {
+	name   string
+	mw, mh int
+	opt    SOp
+}{
+	{"fullscreen", 24, 24, SOp{}},
+	{"scaled", 12, 12, SOp{SW: 2, SH: 2}},
+	{"flipped", 24, 24, SOp{FH: true, FV: true}},
+}
+
+func BenchmarkMap(b *testing.B) {
+	for _, bm := range mapBenchmarks {
+		bm := bm
+		b.Run(bm.name+"/blit", func(b *testing.B) {
+			e := newBlitEngine(1)
+			for i := 0; i < b.N; i++ {
+				e.Map(0, 0, bm.mw, bm.mh, 0, 0, bm.opt)
+			}
+		})
+		b.Run(bm.name+"/pixel", func(b *testing.B) {
+			e := newBlitEngine(1)
+			for i := 0; i < b.N; i++ {
+				pixelMap(e, 0, 0, bm.mw, bm.mh, 0, 0, bm.opt)
+			}
+		})
+	}
+}
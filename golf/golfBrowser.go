@@ -0,0 +1,206 @@
+//go:build js && wasm
+// +build js,wasm
+
+package golf
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"syscall/js"
+	"time"
+)
+
+// defaultBackend is used when EOp.Backend is not set,
+// in the browser this is the browser backend
+func defaultBackend() Backend {
+	return &browser{}
+}
+
+// screenshotKey downloads a screenshot of the game when pressed
+const screenshotKey = F9
+
+// captureKey downloads a gif of the captured frames when pressed
+const captureKey = F10
+
+// browser is the backend used when running in the browser as WASM
+type browser struct {
+	screenBufHook js.Value
+	frame         *frameBuffer
+}
+
+// Init injects the draw js and hooks up the keyboard and mouse listeners
+func (b *browser) Init(e *Engine) {
+	doc := js.Global().Get("document")
+	b.initKeyListener(e, doc)
+	b.initMouseListener(e, js.Global().Get("golfcanvas"))
+
+	// Inject the nessisary JS
+	script := doc.Call("createElement", "script")
+	script.Set("innerHTML", string(drawTemplate[:]))
+	doc.Get("body").Call("appendChild", script)
+
+	// Size the canvas for the screen mode and hook into the injected js
+	w, h := e.ScreenSize()
+	js.Global().Call("initScreen", w, h)
+	b.screenBufHook = js.Global().Get("screenBuff")
+	b.frame = newFrameBuffer(w, h)
+}
+
+// Run calls frame once per requestAnimationFrame
+func (b *browser) Run(frame func(now time.Duration)) {
+	var renderFrame js.Func
+
+	renderFrame = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		// the requestAnimationFrame time stamp is in milliseconds
+		frame(time.Duration(args[0].Float() * float64(time.Millisecond)))
+		js.Global().Call("requestAnimationFrame", renderFrame)
+
+		return nil
+	})
+
+	done := make(chan struct{}, 0)
+
+	js.Global().Call("requestAnimationFrame", renderFrame)
+	<-done
+}
+
+// Present converts the screen buffer to RGBA pixels and draws it on the canvas.
+// Only the rows that changed since the last frame are copied to js
+func (b *browser) Present(screen, pal []byte) {
+	top, bottom := b.frame.update(screen, pal)
+	if top == bottom {
+		return
+	}
+	rowLen := b.frame.w * 4
+	rows := b.screenBufHook.Call("subarray", top*rowLen, bottom*rowLen)
+	js.CopyBytesToJS(rows, b.frame.rgba[top*rowLen:bottom*rowLen])
+	js.Global().Call("drawScreen", top, bottom)
+}
+
+// Store saves the data to the browser's local storage. Older versions of
+// golf saved data as cookies, any cookie with the same name is removed
+// so the data is only kept in one place
+func (b *browser) Store(name string, data []byte) (err error) {
+	// setItem throws if the browser is out of storage space
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("could not save %s: %v", name, r)
+		}
+	}()
+
+	save := base64.StdEncoding.EncodeToString(data)
+	js.Global().Get("localStorage").Call("setItem", name, save)
+	js.Global().Get("document").Set("cookie", name+"=; expires=Thu, 01 Jan 1970 00:00:00 GMT")
+	return nil
+}
+
+// Fetch retrives the named data from the browser's local storage.
+// Data saved as a cookie by older versions of golf is also checked
+func (b *browser) Fetch(name string) ([]byte, bool) {
+	item := js.Global().Get("localStorage").Call("getItem", name)
+	if item.Type() == js.TypeString {
+		ret, err := base64.StdEncoding.DecodeString(item.String())
+		if err != nil {
+			return []byte{}, false
+		}
+		return ret, true
+	}
+
+	cookies := js.Global().Get("document").Get("cookie").String()
+	allCookies := strings.Split(cookies, ";")
+	for _, c := range allCookies {
+		data := strings.Split(c, "=")
+		if len(data) < 2 {
+			continue
+		}
+		n, d := strings.Trim(data[0], " "), strings.Trim(data[1], " ")
+		if n == name {
+			ret, err := base64.StdEncoding.DecodeString(d)
+			if err != nil {
+				return []byte{}, false
+			}
+			return ret, true
+		}
+	}
+	return []byte{}, false
+}
+
+func (b *browser) initKeyListener(e *Engine, doc js.Value) {
+	keyDown := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		k := Key(args[0].Get("keyCode").Int())
+		if k == screenshotKey && !args[0].Get("repeat").Bool() {
+			b.screenshot(e)
+		}
+		if k == captureKey && !args[0].Get("repeat").Bool() {
+			b.captureGIF(e)
+		}
+		e.PressKey(k)
+		return nil
+	})
+	keyUp := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		e.ReleaseKey(Key(args[0].Get("keyCode").Int()))
+		return nil
+	})
+	doc.Call("addEventListener", "keydown", keyDown)
+	doc.Call("addEventListener", "keyup", keyUp)
+}
+
+func (b *browser) initMouseListener(e *Engine, canvas js.Value) {
+	mouseMove := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		w, h := e.ScreenSize()
+		x := args[0].Get("offsetX").Float() * float64(w) / canvas.Get("offsetWidth").Float()
+		y := args[0].Get("offsetY").Float() * float64(h) / canvas.Get("offsetHeight").Float()
+		e.MoveMouse(int(x), int(y))
+
+		return nil
+	})
+	mouseDown := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		e.PressMouse(MouseBtn(args[0].Get("button").Int()))
+		return nil
+	})
+	mouseUp := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		e.ReleaseMouse(MouseBtn(args[0].Get("button").Int()))
+		return nil
+	})
+	canvas.Call("addEventListener", "mousemove", mouseMove)
+	canvas.Call("addEventListener", "mousedown", mouseDown)
+	canvas.Call("addEventListener", "mouseup", mouseUp)
+}
+
+// screenshot downloads the current screen as a png
+func (b *browser) screenshot(e *Engine) {
+	buf := &bytes.Buffer{}
+	err := e.ScreenshotPNG(buf, 4)
+	if err != nil {
+		js.Global().Get("console").Call("error", err.Error())
+		return
+	}
+	b.download(fmt.Sprintf("golf_%d.png", e.Frames()), "image/png", buf.Bytes())
+}
+
+// captureGIF downloads the captured frames as a gif
+func (b *browser) captureGIF(e *Engine) {
+	buf := &bytes.Buffer{}
+	err := e.CaptureGIF(buf, 2)
+	if err != nil {
+		js.Global().Get("console").Call("error", err.Error())
+		return
+	}
+	b.download(fmt.Sprintf("golf_%d.gif", e.Frames()), "image/gif", buf.Bytes())
+}
+
+// download has the browser download the data as a file
+func (b *browser) download(name, mime string, data []byte) {
+	arr := js.Global().Get("Uint8Array").New(len(data))
+	js.CopyBytesToJS(arr, data)
+	blob := js.Global().Get("Blob").New([]interface{}{arr}, map[string]interface{}{"type": mime})
+	url := js.Global().Get("URL").Call("createObjectURL", blob)
+
+	a := js.Global().Get("document").Call("createElement", "a")
+	a.Set("href", url)
+	a.Set("download", name)
+	a.Call("click")
+	js.Global().Get("URL").Call("revokeObjectURL", url)
+}
@@ -0,0 +1,162 @@
+package golf
+
+import "math"
+
+// maxCycles is the number of pallet cycles that can run at once
+const maxCycles = 4
+
+// DPal changes how the screen color col is displayed. It is shown as
+// the shade (0-3) of the given pallet rather than the PalA/PalB color.
+// Unlike Pal this changes pixels that have already been drawn
+func (e *Engine) DPal(col Col, pallet Pal, shade int) {
+	e.RAM[DispPal+int(col&0b00000111)] = 0b10000000 | byte(pallet&0b00001111)<<2 | byte(shade&0b00000011)
+}
+
+// ScanPal sets pallet A and pallet B for the single scanline y.
+// This can be used to show more than 8 colors on screen, e.g. for a sky gradient
+func (e *Engine) ScanPal(y int, palA, palB Pal) {
+	if _, h := e.ScreenSize(); y < 0 || y >= h {
+		return
+	}
+	e.RAM[ScanPal+y] = byte(palA<<4) | byte(palB&0b00001111)
+	e.RAM[ScanPalOn+y/8] |= 1 << (y % 8)
+}
+
+// RScanPal makes the scanline y use the PalA/PalB pallets again
+func (e *Engine) RScanPal(y int) {
+	if _, h := e.ScreenSize(); y < 0 || y >= h {
+		return
+	}
+	e.RAM[ScanPalOn+y/8] &= (1 << (y % 8)) ^ 0b11111111
+}
+
+// PalCycle rotates the screen colors from - to by one color every speed frames.
+// A negative speed rotates the colors backwards and a speed of 0 stops the cycle.
+// slot is the cycle to set (0-3) so several cycles (e.g. water and lava) can run at once
+func (e *Engine) PalCycle(slot int, from, to Col, speed int) {
+	if slot < 0 || slot >= maxCycles {
+		return
+	}
+	from, to = from&0b00000111, to&0b00000111
+	if from > to {
+		from, to = to, from
+	}
+	if speed > 127 {
+		speed = 127
+	}
+	if speed < -127 {
+		speed = -127
+	}
+
+	addr := DispCycle + slot*2
+	e.RAM[addr] = 0
+	if speed != 0 {
+		e.RAM[addr] = 0b10000000 | byte(from)<<3 | byte(to)
+	}
+	e.RAM[addr+1] = toBytes(speed, 1, true)[0]
+}
+
+// Fade fades the whole screen towards black or white. t runs from -1 (black)
+// through 0 (no fade) to 1 (white). Stepping t each frame gives a smooth fade
+func (e *Engine) Fade(t float64) {
+	t = math.Max(-1, math.Min(1, t))
+	e.RAM[DispFade] = toBytes(int(math.Round(t*127)), 1, true)[0]
+}
+
+// RDPal resets the display pallet, stopping any pallet cycles, fades and scanline pallets
+func (e *Engine) RDPal() {
+	for i := DispPal; i < ScanPal+ScreenHeight; i++ {
+		e.RAM[i] = 0
+	}
+}
+
+// displayPallets fills pal with the golfPalette index of the
+// 8 screen colors for every scanline, color n of scanline y
+// is at pal[y*8+n]. pal must be ScreenHeight*8 long, only
+// the scanlines of the engine's screen mode are filled
+func (e *Engine) displayPallets(pal []byte) {
+	fade := e.fadeTable()
+	cycle := e.cycleTable()
+
+	_, h := e.ScreenSize()
+	line := [8]byte{}
+	for y := 0; y < h; y++ {
+		set := e.RAM[ScreenPalSet]
+		if e.RAM[ScanPalOn+y/8]&(1<<(y%8)) > 0 {
+			set = e.RAM[ScanPal+y]
+		}
+		for c := 0; c < 8; c++ {
+			p := set >> 4
+			if c > 3 {
+				p = set & 0b00001111
+			}
+			line[c] = p*4 + byte(c%4)
+			if to := e.RAM[DispPal+c]; to&0b10000000 > 0 {
+				line[c] = to & 0b00111111
+			}
+		}
+		for c := 0; c < 8; c++ {
+			pal[y*8+c] = fade[line[cycle[c]]]
+		}
+	}
+}
+
+// cycleTable returns the screen color that is shown in place of each color
+// once all the running pallet cycles have been applied
+func (e *Engine) cycleTable() [8]int {
+	ret := [8]int{0, 1, 2, 3, 4, 5, 6, 7}
+	frame := e.Frames()
+	for i := 0; i < maxCycles; i++ {
+		set := e.RAM[DispCycle+i*2]
+		speed := toInt(e.RAM[DispCycle+i*2+1:DispCycle+i*2+2], true)
+		if set&0b10000000 == 0 || speed == 0 {
+			continue
+		}
+		from, to := int(set>>3&0b00000111), int(set&0b00000111)
+		n := to - from + 1
+		off := frame / speed % n
+		if speed < 0 {
+			off = (n - (frame/-speed)%n) % n
+		}
+		for c := from; c <= to; c++ {
+			ret[c] = from + (c-from+off)%n
+		}
+	}
+	return ret
+}
+
+// fadeTable maps every golfPalette index to the closest
+// golfPalette color after the screen fade has been applied
+func (e *Engine) fadeTable() [64]byte {
+	ret := [64]byte{}
+	level := toInt(e.RAM[DispFade:DispFade+1], true)
+	for i := range ret {
+		ret[i] = byte(i)
+	}
+	if level == 0 {
+		return ret
+	}
+
+	to := 0.0
+	if level > 0 {
+		to = 255
+	}
+	t := math.Abs(float64(level)) / 127
+	mix := func(c uint8) float64 {
+		return float64(c) + (to-float64(c))*t
+	}
+	for i := range ret {
+		c := pallets[i/4][i%4]
+		r, g, b := mix(c.r), mix(c.g), mix(c.b)
+		best := math.Inf(1)
+		for j := range ret {
+			p := pallets[j/4][j%4]
+			dr, dg, db := r-float64(p.r), g-float64(p.g), b-float64(p.b)
+			if d := dr*dr + dg*dg + db*db; d < best {
+				best = d
+				ret[i] = byte(j)
+			}
+		}
+	}
+	return ret
+}
@@ -0,0 +1,37 @@
+package golf
+
+// drawStateRAM is the RAM that is saved by PushState, each
+// range is the start address and the number of bytes
+var drawStateRAM = [][2]int{
+	{ScreenPalSet, 1},
+	{CameraX, 4},
+	{ClipX, 4},
+	{ActiveSpriteBuff, 2},
+	{DrawTarget, DrawPalT + 1 - DrawTarget}, // draw target, clip high bits, fill pattern and draw pallet
+	{ViewX, 3},
+}
+
+// PushState saves the current draw state. This is the camera, clip, screen pallets,
+// active sprite buffer, draw target, fill pattern, draw pallet and viewport.
+// Every call to PushState should be matched with a call to PopState
+func (e *Engine) PushState() {
+	state := []byte{}
+	for _, r := range drawStateRAM {
+		state = append(state, e.RAM[r[0]:r[0]+r[1]]...)
+	}
+	e.states = append(e.states, state)
+}
+
+// PopState restores the draw state saved by the last call to PushState.
+// If there is no saved state nothing is changed
+func (e *Engine) PopState() {
+	if len(e.states) == 0 {
+		return
+	}
+	state := e.states[len(e.states)-1]
+	e.states = e.states[:len(e.states)-1]
+	for _, r := range drawStateRAM {
+		copy(e.RAM[r[0]:r[0]+r[1]], state)
+		state = state[r[1]:]
+	}
+}
@@ -0,0 +1,190 @@
+package golf
+
+import "math"
+
+// canvas is the draw target with the clip, viewport, draw pallet and fill pattern
+// worked out once. The shape functions use it so they don't have to look these up
+// for every pixel. x and y passed to the canvas are viewport coordinates
+type canvas struct {
+	ram     *[0xFFFF]byte
+	base, w int
+
+	// the clip limited to the draw target, in draw target pixels
+	left, top, right, bottom float64
+
+	vx, vy, z float64
+
+	// the colors drawn for the unset and set bits of the fill pattern
+	pattern uint16
+	col     Col
+	alt     Col
+	altOK   bool
+}
+
+// canvas gets the current draw target ready to draw col
+func (e *Engine) canvas(col Col) canvas {
+	base, w, h := e.drawTarget()
+	cx, cy, cw, ch := e.clipRect()
+	vx, vy, z := e.view()
+	c := canvas{
+		ram: e.RAM, base: base, w: w,
+		left: math.Max(float64(cx), 0), top: math.Max(float64(cy), 0),
+		right: math.Min(float64(cx+cw), float64(w)), bottom: math.Min(float64(cy+ch), float64(h)),
+		vx: vx, vy: vy, z: z,
+		pattern: uint16(e.Peek16(FillPattern)),
+		col:     e.drawCol(col),
+	}
+	if alt := Col(e.RAM[FillCol]); alt != 0 {
+		c.alt, c.altOK = e.drawCol(alt), true
+	}
+	return c
+}
+
+// set sets the draw target pixel x, y, it must be inside the clip
+func (c *canvas) set(x, y int, col Col) {
+	i := x + y*c.w
+	index := c.base + i/8*3 + (i/4)%2
+	pIndex := c.base + i/8*3 + 2
+	cshift, pshift := uint(x%4)*2, uint(x%8)
+	c.ram[index] &= (0b00000011 << cshift) ^ 0b11111111
+	c.ram[index] |= byte(col&0b00000011) << cshift
+	c.ram[pIndex] &= (0b00000001 << pshift) ^ 0b11111111
+	c.ram[pIndex] |= byte(col&0b00000100) >> 2 << pshift
+}
+
+// inside checks if the draw target pixel x, y is inside the clip
+func (c *canvas) inside(x, y float64) bool {
+	return x >= c.left && x < c.right && y >= c.top && y < c.bottom
+}
+
+// pset sets the pixel x, y to col the same way as Pset, col
+// should already be mapped through the draw pallet
+func (c *canvas) pset(x, y float64, col Col) {
+	if c.z == 1 {
+		if c.inside(x+c.vx, y+c.vy) {
+			c.set(int(x+c.vx), int(y+c.vy), col)
+		}
+		return
+	}
+	x, y = math.Floor(x)*c.z+c.vx, math.Floor(y)*c.z+c.vy
+	for j := 0.0; j < c.z; j++ {
+		for i := 0.0; i < c.z; i++ {
+			if c.inside(x+i, y+j) {
+				c.set(int(x+i), int(y+j), col)
+			}
+		}
+	}
+}
+
+// fpset sets the pixel x, y using the fill pattern
+func (c *canvas) fpset(x, y float64) {
+	col, ok := c.patternCol((int(x)%4+4)%4, (int(y)%4+4)%4)
+	if ok {
+		c.pset(x, y, col)
+	}
+}
+
+// patternCol gets the color of the fill pattern for the pixel
+// in column px and row py of the pattern
+func (c *canvas) patternCol(px, py int) (Col, bool) {
+	if c.pattern&(0b1000000000000000>>(px+py*4)) > 0 {
+		return c.alt, c.altOK
+	}
+	return c.col, true
+}
+
+// abs gets the absolute value of i
+func abs(i int) int {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
+
+// hspan fills a horizontal line from x1 to x2 (inclusive) using the fill pattern
+func (c *canvas) hspan(x1, x2, y float64) {
+	if x2 >= x1 {
+		c.span(x1, int(x2-x1)+1, y)
+	}
+}
+
+// span fills the n pixels x, x+1, x+2 ... on row y using the fill pattern.
+// Groups of 8 pixels that line up with the packed bytes are written a byte at a time
+func (c *canvas) span(x float64, n int, y float64) {
+	if n <= 0 {
+		return
+	}
+	py := (int(y)%4 + 4) % 4
+	fx := math.Floor(x)
+
+	// the draw target pixels covered by the span, pixel k
+	// is drawn if x+k is inside the clip just like Pset
+	t1, t2 := fx*c.z+c.vx, (fx+float64(n))*c.z+c.vx
+	if c.z == 1 {
+		k1 := math.Max(0, math.Ceil(c.left-x-c.vx))
+		k2 := math.Min(float64(n), math.Ceil(c.right-x-c.vx))
+		t1, t2 = fx+c.vx+k1, fx+c.vx+k2
+	}
+	t1, t2 = math.Max(t1, c.left), math.Min(t2, c.right)
+	if t1 >= t2 {
+		return
+	}
+	x1, x2 := int(t1), int(t2)
+
+	// colAt gets the color of the draw target pixel tx
+	colAt := func(tx int) (Col, bool) {
+		k := float64(tx) - (fx + c.vx)
+		if c.z > 1 {
+			k = math.Floor((float64(tx)-c.vx)/c.z) - fx
+		}
+		return c.patternCol((int(x+k)%4+4)%4, py)
+	}
+
+	// the bytes for a group of 8 pixels, the pattern repeats every 4 pixels
+	// so one group can be reused for the whole span when the zoom is 1 or 2
+	group, mask := [3]byte{}, [3]byte{}
+	groups := c.pattern == 0 || ((c.z == 1 || c.z == 2) && (x >= 0 || x == fx))
+	if groups {
+		g := (x1 + 7) / 8 * 8
+		for i := 0; i < 8; i++ {
+			col, ok := colAt(g + i)
+			if c.pattern == 0 {
+				col, ok = c.col, true
+			}
+			if !ok {
+				continue
+			}
+			cshift, pshift := uint(i%4)*2, uint(i)
+			group[i/4] |= byte(col&0b00000011) << cshift
+			mask[i/4] |= 0b00000011 << cshift
+			group[2] |= byte(col&0b00000100) >> 2 << pshift
+			mask[2] |= 0b00000001 << pshift
+		}
+	}
+
+	rows := 1
+	ty := y + c.vy
+	if c.z > 1 {
+		rows = int(c.z)
+		ty = math.Floor(y)*c.z + c.vy
+	}
+	for j := 0; j < rows; j++ {
+		if ty+float64(j) < c.top || ty+float64(j) >= c.bottom {
+			continue
+		}
+		row := int(ty) + j
+		for tx := x1; tx < x2; tx++ {
+			if groups && tx%8 == 0 && tx+8 <= x2 {
+				index := c.base + (tx+row*c.w)/8*3
+				for b := 0; b < 3; b++ {
+					c.ram[index+b] = c.ram[index+b]&(mask[b]^0b11111111) | group[b]
+				}
+				tx += 7
+				continue
+			}
+			if col, ok := colAt(tx); ok {
+				c.set(tx, row, col)
+			}
+		}
+	}
+}
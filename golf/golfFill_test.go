@@ -0,0 +1,312 @@
+package golf
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// pixelFill sets a pixel with Pset using the fill pattern,
+// the way the filled shapes were drawn before the canvas
+func pixelFill(e *Engine, x, y float64, col Col) {
+	pattern := e.Peek16(FillPattern)
+	if pattern != 0 {
+		px, py := (int(x)%4+4)%4, (int(y)%4+4)%4
+		if pattern&(0b1000000000000000>>(px+py*4)) > 0 {
+			col = Col(e.RAM[FillCol])
+			if col == 0 {
+				return
+			}
+		}
+	}
+	e.Pset(x, y, col)
+}
+
+// pixelRectFill is RectFill drawn one pixel at a time
+func pixelRectFill(e *Engine, x, y, w, h float64, col Col) {
+	x -= toFloat(e.RAM[CameraX:CameraX+2], true)
+	y -= toFloat(e.RAM[CameraY:CameraY+2], true)
+	for r := 0.0; r < w; r++ {
+		for c := 0.0; c < h; c++ {
+			pixelFill(e, x+r, y+c, col)
+		}
+	}
+}
+
+// pixelCircFill is CircFill drawn one pixel at a time
+func pixelCircFill(e *Engine, xc, yc, r float64, col Col) {
+	if r == 0 {
+		return
+	}
+	xc -= toFloat(e.RAM[CameraX:CameraX+2], true)
+	yc -= toFloat(e.RAM[CameraY:CameraY+2], true)
+	circ8 := func(x, y float64) {
+		for i := 0.0; i < 2*y; i++ {
+			pixelFill(e, xc+x, yc+y-i, col)
+			pixelFill(e, xc-x, yc+y-i, col)
+		}
+		for i := 0.0; i < 2*x; i++ {
+			pixelFill(e, xc+y, yc+x-i, col)
+			pixelFill(e, xc-y, yc+x-i, col)
+		}
+		for _, p := range [8][2]float64{{x, y}, {-x, y}, {x, -y}, {-x, -y}, {y, x}, {-y, x}, {y, -x}, {-y, -x}} {
+			pixelFill(e, xc+p[0], yc+p[1], col)
+		}
+	}
+
+	x, y := 0.0, r
+	d := 3 - 2*r
+	circ8(x, y)
+	for y >= x {
+		x++
+		if d > 0 {
+			y--
+			d = d + 4*(x-y) + 10
+		} else {
+			d = d + 4*x + 6
+		}
+		circ8(x, y)
+	}
+}
+
+// pixelLine is Line drawn one pixel at a time with Pset
+func pixelLine(e *Engine, x1, y1, x2, y2 float64, col Col) {
+	cx := toFloat(e.RAM[CameraX:CameraX+2], true)
+	cy := toFloat(e.RAM[CameraY:CameraY+2], true)
+	x, y := int(math.Floor(x1-cx)), int(math.Floor(y1-cy))
+	ex, ey := int(math.Floor(x2-cx)), int(math.Floor(y2-cy))
+	dx, dy := abs(ex-x), abs(ey-y)
+	sx, sy := 1, 1
+	if ex < x {
+		sx = -1
+	}
+	if ey < y {
+		sy = -1
+	}
+	err := dx - dy
+	for x != ex || y != ey {
+		e.Pset(float64(x), float64(y), col)
+		e2 := 2 * err
+		if e2 > -dy {
+			err -= dy
+			x += sx
+		}
+		if e2 < dx {
+			err += dx
+			y += sy
+		}
+	}
+}
+
+// ddaLine is the line drawing used before Line used Bresenham's algorithm
+func ddaLine(e *Engine, x1, y1, x2, y2 float64, col Col) {
+	dx, dy := x2-x1, y2-y1
+	step := math.Max(math.Abs(dx), math.Abs(dy))
+	dx, dy = dx/step, dy/step
+	x, y := x1, y1
+	for i := 0.0; i < step; i++ {
+		e.Pset(x, y, col)
+		x, y = x+dx, y+dy
+	}
+}
+
+func TestSpanEdges(t *testing.T) {
+	e := newTestEngine(NewHeadless())
+	clips := [][4]int{{0, 0, 192, 192}, {3, 0, 10, 4}, {8, 0, 8, 4}, {5, 0, 1, 4}, {0, 0, 13, 4}}
+	for _, clip := range clips {
+		for _, pattern := range []uint16{0, 0b1010010110100101} {
+			for x := -9.0; x < 20; x += 0.5 {
+				for w := 0.0; w <= 20; w++ {
+					name := fmt.Sprintf("clip %v pattern %b RectFill(%v, 1, %v, 2)", clip, pattern, x, w)
+					compareDraws(t, e, name,
+						func() {
+							e.Cls(Col2)
+							e.Clip(clip[0], clip[1], clip[2], clip[3])
+							e.FillP(pattern, Col5)
+							e.RectFill(x, 1, w, 2, Col7)
+						},
+						func() {
+							e.Cls(Col2)
+							e.Clip(clip[0], clip[1], clip[2], clip[3])
+							e.FillP(pattern, Col5)
+							pixelRectFill(e, x, 1, w, 2, Col7)
+						},
+					)
+				}
+			}
+		}
+	}
+}
+
+func TestRectFill(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	e := newTestEngine(NewHeadless())
+	for i := 0; i < 2000; i++ {
+		randomDrawState(r, e, false, true)
+		x, y := float64(r.Intn(240)-40), float64(r.Intn(240)-40)
+		w, h := float64(r.Intn(80)), float64(r.Intn(80))
+		if r.Intn(3) == 0 {
+			x, w = x+r.Float64(), w+r.Float64()
+		}
+		col := Col(0x80 | r.Intn(8))
+		name := fmt.Sprintf("case %d RectFill(%v, %v, %v, %v, %v)", i, x, y, w, h, col)
+		compareDraws(t, e, name,
+			func() { e.RectFill(x, y, w, h, col) },
+			func() { pixelRectFill(e, x, y, w, h, col) },
+		)
+	}
+}
+
+func TestCircFill(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+	e := newTestEngine(NewHeadless())
+	for i := 0; i < 2000; i++ {
+		randomDrawState(r, e, false, true)
+		x, y := float64(r.Intn(240)-40), float64(r.Intn(240)-40)
+		rad := float64(r.Intn(60))
+		if r.Intn(4) == 0 {
+			x, rad = x+r.Float64(), rad+r.Float64()
+		}
+		col := Col(0x80 | r.Intn(8))
+		name := fmt.Sprintf("case %d CircFill(%v, %v, %v, %v)", i, x, y, rad, col)
+		compareDraws(t, e, name,
+			func() { e.CircFill(x, y, rad, col) },
+			func() { pixelCircFill(e, x, y, rad, col) },
+		)
+	}
+}
+
+func TestLine(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+	e := newTestEngine(NewHeadless())
+	for i := 0; i < 2000; i++ {
+		randomDrawState(r, e, false, true)
+		x1, y1 := float64(r.Intn(300)-60), float64(r.Intn(300)-60)
+		x2, y2 := float64(r.Intn(300)-60), float64(r.Intn(300)-60)
+		if r.Intn(4) == 0 {
+			x1, y2 = x1+r.Float64(), y2+r.Float64()
+		}
+		col := Col(0x80 | r.Intn(8))
+		name := fmt.Sprintf("case %d Line(%v, %v, %v, %v, %v)", i, x1, y1, x2, y2, col)
+		compareDraws(t, e, name,
+			func() { e.Line(x1, y1, x2, y2, col) },
+			func() { pixelLine(e, x1, y1, x2, y2, col) },
+		)
+	}
+}
+
+func TestLineEnds(t *testing.T) {
+	e := newTestEngine(NewHeadless())
+	e.Cls(Col0)
+	e.Line(10, 10, 30, 17, Col7)
+	if e.Pget(10, 10) != Col7 || e.Pget(29, 17) != Col7 {
+		t.Fatal("the line doesn't reach its ends")
+	}
+	if e.Pget(30, 17) != Col0 {
+		t.Fatal("the last point of the line was drawn")
+	}
+
+	// the line is unbroken, each pixel has a neighbour in the next column
+	for x := 10.0; x < 29; x++ {
+		next := false
+		for y := 9.0; y <= 18; y++ {
+			if e.Pget(x, y) == Col7 && (e.Pget(x+1, y-1) == Col7 || e.Pget(x+1, y) == Col7 || e.Pget(x+1, y+1) == Col7) {
+				next = true
+			}
+		}
+		if !next {
+			t.Fatalf("the line is broken after column %v", x)
+		}
+	}
+}
+
+// fillBenchmarks draws each shape with the canvas and the per pixel reference
+var fillBenchmarks = []struct {
+	name          string
+	canvas, pixel func(e *Engine)
+}{
+	{"RectFill/fullscreen",
+		func(e *Engine) { e.RectFill(0, 0, 192, 192, Col3) },
+		func(e *Engine) { pixelRectFill(e, 0, 0, 192, 192, Col3) }},
+	{"RectFill/particles",
+		func(e *Engine) {
+			for i := 0; i < 200; i++ {
+				e.RectFill(float64(i*7%190), float64(i*13%190), 3, 3, Col3)
+			}
+		},
+		func(e *Engine) {
+			for i := 0; i < 200; i++ {
+				pixelRectFill(e, float64(i*7%190), float64(i*13%190), 3, 3, Col3)
+			}
+		}},
+	{"RectFill/pattern",
+		func(e *Engine) { e.FillP(0b1010010110100101, Col1); e.RectFill(3, 3, 180, 180, Col3) },
+		func(e *Engine) { e.FillP(0b1010010110100101, Col1); pixelRectFill(e, 3, 3, 180, 180, Col3) }},
+	{"CircFill/large",
+		func(e *Engine) { e.CircFill(96, 96, 90, Col3) },
+		func(e *Engine) { pixelCircFill(e, 96, 96, 90, Col3) }},
+	{"CircFill/particles",
+		func(e *Engine) {
+			for i := 0; i < 200; i++ {
+				e.CircFill(float64(i*7%190), float64(i*13%190), 3, Col3)
+			}
+		},
+		func(e *Engine) {
+			for i := 0; i < 200; i++ {
+				pixelCircFill(e, float64(i*7%190), float64(i*13%190), 3, Col3)
+			}
+		}},
+	{"Line/long",
+		func(e *Engine) { e.Line(0, 0, 191, 150, Col3) },
+		func(e *Engine) { ddaLine(e, 0, 0, 191, 150, Col3) }},
+	{"Line/particles",
+		func(e *Engine) {
+			for i := 0; i < 200; i++ {
+				x, y := float64(i*7%190), float64(i*13%190)
+				e.Line(x, y, x+5, y+3, Col3)
+			}
+		},
+		func(e *Engine) {
+			for i := 0; i < 200; i++ {
+				x, y := float64(i*7%190), float64(i*13%190)
+				ddaLine(e, x, y, x+5, y+3, Col3)
+			}
+		}},
+}
+
+// benchmarkFill runs the fill benchmarks for shape
+func benchmarkFill(b *testing.B, shape string) {
+	for _, bm := range fillBenchmarks {
+		bm := bm
+		if !strings.HasPrefix(bm.name, shape+"/") {
+			continue
+		}
+		name := strings.TrimPrefix(bm.name, shape+"/")
+		b.Run(name+"/canvas", func(b *testing.B) {
+			e := newTestEngine(NewHeadless())
+			for i := 0; i < b.N; i++ {
+				bm.canvas(e)
+			}
+		})
+		b.Run(name+"/pixel", func(b *testing.B) {
+			e := newTestEngine(NewHeadless())
+			for i := 0; i < b.N; i++ {
+				bm.pixel(e)
+			}
+		})
+	}
+}
+
+func BenchmarkRectFill(b *testing.B) {
+	benchmarkFill(b, "RectFill")
+}
+
+func BenchmarkCircFill(b *testing.B) {
+	benchmarkFill(b, "CircFill")
+}
+
+func BenchmarkLine(b *testing.B) {
+	benchmarkFill(b, "Line")
+}
@@ -0,0 +1,102 @@
+package golf
+
+// Font is a bitmap font drawn from the user sprite sheet. The glyphs are
+// W x H cells laid out left to right starting at X, Y on the sprite sheet,
+// wrapping onto the next row of cells at the edge of the sprite sheet
+type Font struct {
+	// Chars is the character map, the nth character in Chars is drawn using the nth glyph
+	Chars string
+
+	// X, Y is the sprite sheet pixel of the top left corner of the first glyph
+	X, Y int
+
+	// W, H is the size of each glyph cell in pixels
+	W, H int
+
+	// Widths is how far the cursor moves after each glyph so narrow glyphs like 'i'
+	// take up less space. Glyphs without a width move the cursor W pixels
+	Widths []int
+
+	// LineH is the height of a line of text, the default is H
+	LineH int
+
+	// Ink is the color of the glyphs that is changed by TOp.Col, the default is Col7.
+	// TCol is the background color of the glyphs that is not drawn, the default is Col0
+	Ink, TCol Col
+
+	// Data is optional packed pixel data for the glyphs, e.g. from the golf toolkit font
+	// command. It's full sprite sheet rows which AddFont copies into the sprite sheet at row Y
+	Data []byte
+}
+
+// font is a font that has been added to the engine
+type font struct {
+	Font
+	index map[rune]int
+}
+
+// AddFont adds the font to the engine and returns its number. Use the number
+// as TOp.Font or in a {f1}-{f9} markup tag to draw text using the font.
+// Font 0 is the built in font
+func (e *Engine) AddFont(f Font) int {
+	if f.W <= 0 || f.H <= 0 {
+		return 0
+	}
+	if f.LineH <= 0 {
+		f.LineH = f.H
+	}
+	if f.Ink == 0 {
+		f.Ink = Col7
+	}
+	if f.TCol == 0 {
+		f.TCol = Col0
+	}
+	if len(f.Data) > 0 {
+		addr := SpriteBase + f.Y*256/8*3
+		end := SpriteBase + 256*128/8*3
+		if addr >= SpriteBase && addr < end {
+			copy(e.RAM[addr:end], f.Data)
+		}
+	}
+
+	add := &font{Font: f, index: map[rune]int{}}
+	i := 0
+	for _, r := range f.Chars {
+		if _, ok := add.index[r]; !ok {
+			add.index[r] = i
+		}
+		i++
+	}
+	e.fonts = append(e.fonts, add)
+	return len(e.fonts)
+}
+
+// font gets the added font n, nil is returned for the built in font
+func (e *Engine) font(n int) *font {
+	if n <= 0 || n > len(e.fonts) {
+		return nil
+	}
+	return e.fonts[n-1]
+}
+
+// cell gets the sprite sheet pixel of the top left corner of glyph i
+func (f *font) cell(i int) (x, y int) {
+	cols := (256 - f.X) / f.W
+	if cols < 1 {
+		cols = 1
+	}
+	return f.X + i%cols*f.W, f.Y + i/cols*f.H
+}
+
+// glyphSize gets how far the cursor moves after glyph i of font n and the height of the line
+func (e *Engine) glyphSize(n, i int) (w, h float64) {
+	f := e.font(n)
+	if f == nil {
+		return 6, 6
+	}
+	w = float64(f.W)
+	if i >= 0 && i < len(f.Widths) {
+		w = float64(f.Widths[i])
+	}
+	return w, float64(f.LineH)
+}
@@ -0,0 +1,72 @@
+package golf
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestFontChars(t *testing.T) {
+	e := NewEngine(func() {}, func() {}, EOp{Backend: NewHeadless()})
+	font := e.AddFont(Font{Chars: "aé€Ñ", W: 8, H: 8, Widths: []int{4, 5, 6, 7}})
+
+	l := e.layoutText("é€a^ñ?", TOp{Font: font}.style(), 0)
+	want := []struct{ dex, font int }{{1, font}, {2, font}, {0, font}, {3, font}, {strings.IndexRune(textRef, '?'), 0}}
+	if len(l.glyphs) != len(want) {
+		t.Fatalf("got %d glyphs, want %d", len(l.glyphs), len(want))
+	}
+	for i, g := range l.glyphs {
+		if g.dex != want[i].dex || g.font != want[i].font {
+			t.Errorf("glyph %d is %d in font %d, want %d in font %d", i, g.dex, g.font, want[i].dex, want[i].font)
+		}
+	}
+
+	if w := e.TextWidth("é€", TOp{Font: font}); w != 11 {
+		t.Errorf("TextWidth is %v, want 11", w)
+	}
+}
+
+// newFontEngine creates an engine with a solid block font that is h pixels tall
+// and a solid block font that is 4 pixels tall
+func newFontEngine(h int) (e *Engine, tall, short int) {
+	e = newTestEngine(NewHeadless())
+	e.Memset(SpriteBase, 0xff, 256/8*3*16)
+	return e, e.AddFont(Font{Chars: "a", W: 4, H: h}), e.AddFont(Font{Chars: "a", W: 4, H: 4})
+}
+
+func TestFontTextBox(t *testing.T) {
+	e, tall, short := newFontEngine(12)
+	cases := []struct {
+		font int
+		h    float64
+		want string
+	}{
+		{tall, 20, "a"},
+		{tall, 24, "a\na"},
+		{short, 7, "a"},
+		{short, 8, "a\na"},
+	}
+	for _, c := range cases {
+		opt := TOp{Font: c.font, Col: Col3}
+		got := drawScreen(e, func() { e.TextBox(10, 10, 40, c.h, "a\na", AlignLeft, opt) })
+		want := drawScreen(e, func() { e.Text(10, 10, c.want, opt) })
+		if !bytes.Equal(got, want) {
+			t.Errorf("font %d in a box %v high isn't drawn as %q", c.font, c.h, c.want)
+		}
+	}
+}
+
+func TestFontTextLR(t *testing.T) {
+	e, tall, _ := newFontEngine(12)
+	opt := TOp{Font: tall, Col: Col3, Fixed: true}
+	w, _ := e.ScreenSize()
+
+	want := drawScreen(e, func() { e.Text(1, 1, "a\na\na", opt) })
+	if got := drawScreen(e, func() { e.TextL("a\na", opt); e.TextL("a", opt) }); !bytes.Equal(got, want) {
+		t.Error("TextL lines don't follow the height of the font")
+	}
+	want = drawScreen(e, func() { e.Text(float64(w)-5, 1, "a\na\na", opt) })
+	if got := drawScreen(e, func() { e.TextR("a\na", opt); e.TextR("a", opt) }); !bytes.Equal(got, want) {
+		t.Error("TextR lines don't follow the height of the font")
+	}
+}
@@ -0,0 +1,79 @@
+package golf
+
+import "bytes"
+
+// golfRGBA is the full 64 color GoLF pallet as RGBA bytes,
+// color n of pallet p is at index p*4+n
+var golfRGBA = func() [64][4]byte {
+	ret := [64][4]byte{}
+	for i := range ret {
+		c := pallets[i/4][i%4]
+		ret[i] = [4]byte{c.r, c.g, c.b, 255}
+	}
+	return ret
+}()
+
+// frameBuffer converts the packed screen buffer into RGBA pixels.
+// It keeps a copy of the last screen so only rows that changed are converted
+type frameBuffer struct {
+	w, h   int
+	rgba   []byte
+	screen []byte
+	pal    []byte
+	drawn  bool
+}
+
+// newFrameBuffer creates a frame buffer for a w x h screen
+func newFrameBuffer(w, h int) *frameBuffer {
+	return &frameBuffer{
+		w: w, h: h,
+		rgba:   make([]byte, w*h*4),
+		screen: make([]byte, w*h/8*3),
+		pal:    make([]byte, h*8),
+	}
+}
+
+// update converts the rows of the screen that have changed since the last update.
+// screen is the packed screen buffer and pal is the display pallet. top and bottom
+// are the first changed row and the row after the last changed row, if nothing
+// changed top and bottom are both 0
+func (f *frameBuffer) update(screen, pal []byte) (top, bottom int) {
+	rowLen := f.w / 8 * 3
+	top, bottom = f.h, 0
+	for y := 0; y < f.h; y++ {
+		row, rowPal := screen[y*rowLen:(y+1)*rowLen], pal[y*8:(y+1)*8]
+		if f.drawn && bytes.Equal(row, f.screen[y*rowLen:(y+1)*rowLen]) && bytes.Equal(rowPal, f.pal[y*8:(y+1)*8]) {
+			continue
+		}
+		copy(f.screen[y*rowLen:], row)
+		copy(f.pal[y*8:], rowPal)
+		f.convertRow(y)
+		if y < top {
+			top = y
+		}
+		bottom = y + 1
+	}
+	f.drawn = true
+	if bottom == 0 {
+		return 0, 0
+	}
+	return top, bottom
+}
+
+// convertRow converts row y of f.screen into RGBA pixels
+func (f *frameBuffer) convertRow(y int) {
+	cols := [8][4]byte{}
+	for i := range cols {
+		cols[i] = golfRGBA[f.pal[y*8+i]&0b00111111]
+	}
+
+	src := f.screen[y*f.w/8*3:]
+	dst := f.rgba[y*f.w*4:]
+	for g := 0; g < f.w/8; g++ {
+		shade, pallet := uint(src[g*3])|uint(src[g*3+1])<<8, uint(src[g*3+2])
+		for i := 0; i < 8; i++ {
+			c := cols[shade>>(i*2)&0b00000011|(pallet>>i&0b00000001)<<2]
+			copy(dst[(g*8+i)*4:], c[:])
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package golf
+
+import (
+	"bytes"
+	"testing"
+)
+
+// checkFrame fails if the frame buffer pixels aren't the engine's screen colors looked up in the display pallet
+func checkFrame(t *testing.T, step string, e *Engine, h *Headless, f *frameBuffer) {
+	t.Helper()
+	for y := 0; y < f.h; y++ {
+		for x := 0; x < f.w; x++ {
+			col := e.Pget(float64(x), float64(y)) & 0b00000111
+			want := golfRGBA[h.Pal[y*8+int(col)]&0b00111111]
+			if got := f.rgba[(y*f.w+x)*4 : (y*f.w+x+1)*4]; !bytes.Equal(got, want[:]) {
+				t.Fatalf("%s: pixel %d, %d is %v, want %v", step, x, y, got, want)
+			}
+		}
+	}
+}
+
+func TestFrameBuffer(t *testing.T) {
+	for _, mode := range []Mode{Mode192x192, Mode96x96, Mode256x144} {
+		h := NewHeadless()
+		e := newTestEngine(h, EOp{Mode: mode})
+		w, sh := e.ScreenSize()
+		f := newFrameBuffer(w, sh)
+
+		var draw func()
+		e.Draw = func() { draw() }
+		e.Run()
+
+		steps := []struct {
+			name        string
+			draw        func()
+			top, bottom int
+		}{
+			{"first frame", func() {
+				e.Cls(Col0)
+				for i := 0; i < w*3; i++ {
+					e.Pset(float64(i%w), float64(i%sh), Col(0x80|i%8))
+				}
+			}, 0, sh},
+			{"nothing changed", func() {}, 0, 0},
+			{"two pixels", func() {
+				e.Pset(3, 5, Col7)
+				e.Pset(float64(w-1), float64(sh-2), Col2)
+			}, 5, sh - 1},
+			{"top row", func() { e.Pset(0, 0, Col6) }, 0, 1},
+			{"bottom row", func() { e.Pset(float64(w-8), float64(sh-1), Col5) }, sh - 1, sh},
+			{"redraw the same pixel", func() { e.Pset(0, 0, Col6) }, 0, 0},
+			{"scanline pallet", func() { e.ScanPal(10, Pal3, Pal4) }, 10, 11},
+			{"display pallet", func() { e.DPal(Col1, Pal5, 2) }, 0, sh},
+		}
+		for _, s := range steps {
+			draw = s.draw
+			h.Step(1)
+			top, bottom := f.update(h.Screen, h.Pal)
+			if top != s.top || bottom != s.bottom {
+				t.Errorf("mode %d %s: rows %d-%d changed, want %d-%d", mode, s.name, top, bottom, s.top, s.bottom)
+			}
+			checkFrame(t, s.name, e, h, f)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package golf
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/gif"
+	"io"
+	"time"
+)
+
+// captureEvery is how often a frame is captured, every other frame
+// keeps a 60fps game under the 50fps most gif players can show
+const captureEvery = 2
+
+// captureFrame is one captured screen buffer
+type captureFrame struct {
+	screen []byte // the screen buffer and screen pallet byte
+	pal    []byte // the display pallet
+	frame  int    // the frame the screen was captured on
+}
+
+// capture is a ring buffer of the last captured frames
+type capture struct {
+	frames []captureFrame
+	next   int
+	full   bool
+}
+
+// StartCapture starts keeping the last seconds worth of frames so they
+// can be saved as a gif with CaptureGIF
+func (e *Engine) StartCapture(seconds float64) {
+	n := int(seconds*float64(time.Second/e.step)) / captureEvery
+	if n < 1 {
+		n = 1
+	}
+	e.capture = &capture{frames: make([]captureFrame, n)}
+}
+
+// StopCapture stops capturing frames and frees the captured frames
+func (e *Engine) StopCapture() {
+	e.capture = nil
+}
+
+// captureScreen adds the screen buffer to the capture ring buffer
+func (e *Engine) captureScreen() {
+	c := e.capture
+	if c == nil {
+		return
+	}
+	frame := e.Frames()
+	last := c.next - 1
+	if last < 0 {
+		last = len(c.frames) - 1
+	}
+	if (c.full || c.next > 0) && frame-c.frames[last].frame < captureEvery {
+		return
+	}
+
+	f := &c.frames[c.next]
+	if f.screen == nil {
+		f.screen = make([]byte, ScreenPalSet+1)
+		f.pal = make([]byte, ScreenHeight*8)
+	}
+	copy(f.screen, e.RAM[:ScreenPalSet+1])
+	copy(f.pal, e.display)
+	f.frame = frame
+
+	c.next++
+	if c.next == len(c.frames) {
+		c.next = 0
+		c.full = true
+	}
+}
+
+// CaptureGIF writes the captured frames to w as an animated gif
+// scale is the integer amount to scale each pixel by
+func (e *Engine) CaptureGIF(w io.Writer, scale int) error {
+	c := e.capture
+	if c == nil {
+		return errors.New("capture has not been started")
+	}
+	frames := c.frames[:c.next]
+	if c.full {
+		frames = append(append([]captureFrame{}, c.frames[c.next:]...), c.frames[:c.next]...)
+	}
+	if len(frames) == 0 {
+		return errors.New("no frames have been captured")
+	}
+
+	// gif delays are in 100ths of a second, round the running
+	// time rather than each delay so the gif doesn't drift
+	cs := func(frame int) int {
+		return int((time.Duration(frame-frames[0].frame)*e.step + 5*time.Millisecond) / (10 * time.Millisecond))
+	}
+
+	sw, sh := e.ScreenSize()
+	anim := &gif.GIF{}
+	for i, f := range frames {
+		next := f.frame + captureEvery
+		if i+1 < len(frames) {
+			next = frames[i+1].frame
+		}
+		delay := cs(next) - cs(f.frame)
+
+		// merge frames that didn't change to keep the gif small
+		if i > 0 && bytes.Equal(f.screen, frames[i-1].screen) && bytes.Equal(f.pal, frames[i-1].pal) {
+			anim.Delay[len(anim.Delay)-1] += delay
+			continue
+		}
+		anim.Image = append(anim.Image, scaleImage(screenImage(f.screen, f.pal, sw, sh), scale))
+		anim.Delay = append(anim.Delay, delay)
+	}
+
+	return gif.EncodeAll(w, anim)
+}
+
+// screenImage converts a screen buffer into an image using the golfPalette.
+// pal is the display pallet, 8 golfPalette indices for each scanline.
+// w and h are the size of the screen in pixels
+func screenImage(screen, pal []byte, w, h int) *image.Paletted {
+	img := image.NewPaletted(image.Rect(0, 0, w, h), golfPalette)
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			col := unpackPixel(screen, x, y, w) & 0b00000111
+			img.Pix[x+y*img.Stride] = pal[y*8+int(col)]
+		}
+	}
+	return img
+}
+
+// unpackPixel gets a pixel from a packed pixel buffer
+// pxlWidth is the width of the buffer in pixels
+func unpackPixel(buf []byte, x, y, pxlWidth int) Col {
+	i := x + y*pxlWidth
+	index := i / 8 * 3
+	pIndex := index + 2
+	cshift := (x % 4) * 2
+	pshift := x % 8
+	if (i/4)%2 == 1 {
+		index++
+	}
+	color := (buf[index] >> cshift) & 0b00000011
+	pallet := (buf[pIndex] >> pshift) & 0b00000001
+
+	return Col((color | (pallet << 2)) | 0b10000000)
+}
@@ -0,0 +1,112 @@
+package golf
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"time"
+)
+
+// Headless is an in memory backend. It does not need a browser
+// so it can be used to run and test golf games natively. A zero
+// value Headless is ready to use
+type Headless struct {
+	// Frames is the number of updates Run steps through before returning.
+	// If Frames is 0 Run returns right away and Step must be used instead
+	Frames int
+
+	// Screen is a copy of the last screen buffer presented by the engine
+	Screen []byte
+
+	// Pal is a copy of the last display pallet presented by the engine
+	Pal []byte
+
+	// Dir is the directory cart data is saved to.
+	// If Dir is empty cart data is only kept in memory
+	Dir string
+
+	frame func(now time.Duration)
+	now   time.Duration
+	step  time.Duration
+	cart  map[string][]byte
+}
+
+// NewHeadless creates a new headless backend
+func NewHeadless() *Headless {
+	return &Headless{
+		Screen: make([]byte, ScreenPalSet+1),
+		Pal:    make([]byte, ScreenHeight*8),
+		cart:   map[string][]byte{},
+	}
+}
+
+// Init gets the engine's update rate so that each Step is exactly one update.
+// Headless input is passed to the engine directly
+func (h *Headless) Init(e *Engine) {
+	h.step = e.step
+	if h.Screen == nil {
+		h.Screen = make([]byte, ScreenPalSet+1)
+	}
+	if h.Pal == nil {
+		h.Pal = make([]byte, ScreenHeight*8)
+	}
+	if h.cart == nil {
+		h.cart = map[string][]byte{}
+	}
+}
+
+// Run saves the frame function and steps through h.Frames updates
+func (h *Headless) Run(frame func(now time.Duration)) {
+	h.frame = frame
+	h.Step(h.Frames)
+}
+
+// Step runs n updates, moving the clock forward one time step for each.
+// Run must be called on the engine first
+func (h *Headless) Step(n int) {
+	if h.frame == nil {
+		return
+	}
+	for i := 0; i < n; i++ {
+		h.now += h.step
+		h.frame(h.now)
+	}
+}
+
+// Present copies the screen buffer into h.Screen and the display pallet into h.Pal
+func (h *Headless) Present(screen, pal []byte) {
+	copy(h.Screen, screen)
+	copy(h.Pal, pal)
+}
+
+// Store saves a copy of the cart data in memory and to h.Dir if it's set
+func (h *Headless) Store(name string, data []byte) error {
+	if h.cart == nil {
+		h.cart = map[string][]byte{}
+	}
+	h.cart[name] = append([]byte{}, data...)
+	if h.Dir == "" {
+		return nil
+	}
+	return ioutil.WriteFile(filepath.Join(h.Dir, name), data, 0666)
+}
+
+// Fetch gets the cart data from memory or from h.Dir if it's set
+func (h *Headless) Fetch(name string) ([]byte, bool) {
+	data, ok := h.cart[name]
+	if ok {
+		return append([]byte{}, data...), true
+	}
+	if h.Dir == "" {
+		return []byte{}, false
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(h.Dir, name))
+	if err != nil {
+		return []byte{}, false
+	}
+	if h.cart == nil {
+		h.cart = map[string][]byte{}
+	}
+	h.cart[name] = data
+	return append([]byte{}, data...), true
+}
@@ -0,0 +1,118 @@
+package golf
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+// newTestEngine creates an engine on the headless backend h with the startup
+// animation turned off, so Update and Draw are called from the first frame
+func newTestEngine(h *Headless, opts ...EOp) *Engine {
+	opt := EOp{}
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	opt.Backend = h
+	e := NewEngine(func() {}, func() {}, opt)
+	e.RAM[StartAnim] = 0
+	return e
+}
+
+func TestHeadlessRun(t *testing.T) {
+	h := NewHeadless()
+	h.Frames = 10
+	e := newTestEngine(h)
+
+	updates, draws := 0, 0
+	e.Update = func() { updates++ }
+	e.Draw = func() {
+		draws++
+		e.Cls(Col0)
+		e.Pset(float64(updates), 0, Col7)
+	}
+
+	e.Run()
+	if updates != 10 || draws != 10 {
+		t.Fatalf("Run called Update %d and Draw %d times, want 10", updates, draws)
+	}
+	h.Step(5)
+	if updates != 15 || e.Frames() != 15 {
+		t.Fatalf("Step(5) left %d updates and %d frames, want 15", updates, e.Frames())
+	}
+
+	// the presented screen has the last frame's pixel
+	if !bytes.Equal(h.Screen, e.RAM[:ScreenPalSet+1]) {
+		t.Fatal("Headless.Screen doesn't match the screen buffer")
+	}
+	if e.Pget(15, 0) != Col7 || e.Pget(14, 0) != Col0 {
+		t.Fatal("the last frame wasn't drawn to the screen")
+	}
+}
+
+func TestHeadlessStepBeforeRun(t *testing.T) {
+	h := NewHeadless()
+	e := newTestEngine(h)
+	h.Step(3)
+	if e.Frames() != 0 {
+		t.Fatalf("Step ran %d frames before Run", e.Frames())
+	}
+}
+
+func TestHeadlessStoreFetch(t *testing.T) {
+	h := NewHeadless()
+	e := newTestEngine(h)
+
+	if _, ok := e.Dget("missing"); ok {
+		t.Fatal("Dget found data that was never saved")
+	}
+	if err := e.Dset("score", []byte{1, 2, 3}); err != nil {
+		t.Fatal(err)
+	}
+	data, ok := e.Dget("score")
+	if !ok || !bytes.Equal(data, []byte{1, 2, 3}) {
+		t.Fatalf("Dget got %v, %v", data, ok)
+	}
+
+	// the fetched data is a copy
+	data[0] = 9
+	if data, _ := e.Dget("score"); data[0] != 1 {
+		t.Fatal("changing fetched data changed the stored data")
+	}
+}
+
+func TestHeadlessDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "golf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := (&Headless{Dir: dir}).Store("save", []byte("golf")); err != nil {
+		t.Fatal(err)
+	}
+	data, ok := (&Headless{Dir: dir}).Fetch("save")
+	if !ok || string(data) != "golf" {
+		t.Fatalf("Fetch got %q, %v", data, ok)
+	}
+}
+
+func TestHeadlessZeroValue(t *testing.T) {
+	h := &Headless{}
+	if err := h.Store("save", []byte{1}); err != nil {
+		t.Fatal(err)
+	}
+	if data, ok := h.Fetch("save"); !ok || data[0] != 1 {
+		t.Fatalf("Fetch got %v, %v", data, ok)
+	}
+
+	h = &Headless{}
+	e := newTestEngine(h)
+	e.Draw = func() { e.Cls(Col3) }
+	e.Run()
+	h.Step(1)
+	if !bytes.Equal(h.Screen, e.RAM[:ScreenPalSet+1]) {
+		t.Fatal("a zero value Headless didn't present the screen")
+	}
+}
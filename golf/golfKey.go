@@ -1,9 +1,5 @@
 package golf
 
-import (
-	"syscall/js"
-)
-
 // Golf Key Codes, Mirrors JS key code values
 const (
 	Backspace    = Key(8)
@@ -121,51 +117,52 @@ const (
 	pressed   = btnState(3)
 )
 
-func (e *Engine) initKeyListener(doc js.Value) {
-	keyDown := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
-		// Get KeyCode starting at 0
-		k := Key(args[0].Get("keyCode").Int()) - Backspace
-		masks := []byte{
-			0b00000011,
-			0b00001100,
-			0b00110000,
-			0b11000000,
-		}
+// PressKey marks the key as pressed, backends use this to pass keyboard events to the engine
+func (e *Engine) PressKey(key Key) {
+	// Get KeyCode starting at 0
+	k := key - Backspace
+	if k < 0 || k > Quotes-Backspace {
+		return
+	}
+	masks := []byte{
+		0b00000011,
+		0b00001100,
+		0b00110000,
+		0b11000000,
+	}
 
-		addr := keyBase + int(k/4)
-		b := e.RAM[addr]
-		m := masks[k%4]
+	addr := KeyBase + int(k/4)
+	b := e.RAM[addr]
+	m := masks[k%4]
+	btn := btnState(b & m)
+	if btn == unpressed {
 		shift := (k % 4) * 2
-		btn := btnState(b & m)
-		if btn == unpressed {
-			e.RAM[addr] |= (byte(start) << shift)
-		}
-
-		return nil
-	})
-	keyUp := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
-		k := Key(args[0].Get("keyCode").Int()) - Backspace
-		masks := []byte{
-			0b00000011,
-			0b00001100,
-			0b00110000,
-			0b11000000,
-		}
+		e.RAM[addr] |= (byte(start) << shift)
+	}
+}
 
-		addr := keyBase + int(k/4)
-		b := e.RAM[addr]
-		m := masks[k%4]
-		shift := (k % 4) * 2
-		btn := btnState(b & m >> shift)
-		if btn == pressed || btn == start {
-			e.RAM[addr] &= (m ^ 0b11111111)
-			e.RAM[addr] |= (byte(end) << shift)
-		}
+// ReleaseKey marks the key as released, backends use this to pass keyboard events to the engine
+func (e *Engine) ReleaseKey(key Key) {
+	k := key - Backspace
+	if k < 0 || k > Quotes-Backspace {
+		return
+	}
+	masks := []byte{
+		0b00000011,
+		0b00001100,
+		0b00110000,
+		0b11000000,
+	}
 
-		return nil
-	})
-	doc.Call("addEventListener", "keydown", keyDown)
-	doc.Call("addEventListener", "keyup", keyUp)
+	addr := KeyBase + int(k/4)
+	b := e.RAM[addr]
+	m := masks[k%4]
+	shift := (k % 4) * 2
+	btn := btnState(b & m >> shift)
+	if btn == pressed || btn == start {
+		e.RAM[addr] &= (m ^ 0b11111111)
+		e.RAM[addr] |= (byte(end) << shift)
+	}
 }
 
 func (e *Engine) tickKeyboard() {
@@ -177,7 +174,7 @@ func (e *Engine) tickKeyboard() {
 	}
 	for i := Backspace; i < Quotes; i++ {
 		k := byte(i - Backspace)
-		addr := keyBase + int(k/4)
+		addr := KeyBase + int(k/4)
 		b := e.RAM[addr]
 		m := masks[k%4]
 		shift := (k % 4) * 2
@@ -208,7 +205,7 @@ func (e *Engine) Btn(key Key) bool {
 	}
 
 	k := key - Backspace
-	addr := keyBase + int(k/4)
+	addr := KeyBase + int(k/4)
 	b := e.RAM[addr]
 	m := masks[k%4]
 	shift := (k % 4) * 2
@@ -229,7 +226,7 @@ func (e *Engine) Btnp(key Key) bool {
 	}
 
 	k := key - Backspace
-	addr := keyBase + int(k/4)
+	addr := KeyBase + int(k/4)
 	b := e.RAM[addr]
 	m := masks[k%4]
 	shift := (k % 4) * 2
@@ -250,7 +247,7 @@ func (e *Engine) Btnr(key Key) bool {
 	}
 
 	k := key - Backspace
-	addr := keyBase + int(k/4)
+	addr := KeyBase + int(k/4)
 	b := e.RAM[addr]
 	m := masks[k%4]
 	shift := (k % 4) * 2
@@ -1,9 +1,13 @@
 package golf
 
+import (
+	"math"
+)
+
 // LoadMap loads the sprite sheet into memory
 func (e *Engine) LoadMap(mapData [0x4800]byte) {
 	for i, b := range mapData {
-		e.RAM[mapBase-i] = b
+		e.RAM[MapBase-i] = b
 	}
 }
 
@@ -27,20 +31,23 @@ func (e *Engine) Map(mx, my, mw, mh int, dx, dy float64, opts ...SOp) {
 	if opt.SW == 0 {
 		opt.SW = 1
 	}
-	cx := toInt(e.RAM[cameraX:cameraX+2], true)
-	cy := toInt(e.RAM[cameraY:cameraY+2], true)
+	cx := toInt(e.RAM[CameraX:CameraX+2], true)
+	cy := toInt(e.RAM[CameraY:CameraY+2], true)
 	if opt.Fixed {
 		cx, cy = 0, 0
 	}
 
+	// skip tiles outside the clipping rect, flipped tiles can be drawn 1 pixel over
+	clipX, clipY, clipW, clipH := e.viewClip()
+	tw, th := int(float64(8*opt.W)*opt.SW)+1, int(float64(8*opt.H)*opt.SH)+1
 	for x := 0; x < mw; x++ {
 		sprX := int(float64(int(dx)+x*8*opt.W) * roundPxl(opt.SW, float64(8*opt.W)))
-		if !tileInboundsX(sprX-cx, opt) {
+		if sprX-cx+tw < clipX || sprX-cx >= clipX+clipW {
 			continue
 		}
 		for y := 0; y < mh; y++ {
 			sprY := int(float64(int(dy)+y*8*opt.H) * roundPxl(opt.SH, float64(8*opt.H)))
-			if !tileInboundsY(sprY-cy, opt) {
+			if sprY-cy+th < clipY || sprY-cy >= clipY+clipH {
 				continue
 			}
 			s := e.Mget(x+mx, y+my)
@@ -52,6 +59,46 @@ func (e *Engine) Map(mx, my, mw, mh int, dx, dy float64, opts ...SOp) {
 	}
 }
 
+// TLine draws a textured line from x1, y1 to x2, y2. Each pixel is sampled from
+// the map, mx, my is the map coordinate (in tiles) of the first pixel and
+// mdx, mdy is how far to move across the map for each pixel (1/8 is one texel).
+// The map wraps and tile 0 is transparent. This is useful for mode-7 floors and raycasters
+func (e *Engine) TLine(x1, y1, x2, y2, mx, my, mdx, mdy float64, opts ...SOp) {
+	opt := SOp{}
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	if !opt.Fixed {
+		cx := toFloat(e.RAM[CameraX:CameraX+2], true)
+		cy := toFloat(e.RAM[CameraY:CameraY+2], true)
+		x1, x2 = x1-cx, x2-cx
+		y1, y2 = y1-cy, y2-cy
+	}
+	buffBase := toInt(e.RAM[ActiveSpriteBuff:ActiveSpriteBuff+2], false)
+
+	dx, dy := x2-x1, y2-y1
+	step := math.Max(math.Abs(dx), math.Abs(dy))
+	if step > 0 {
+		dx, dy = dx/step, dy/step
+	}
+
+	x, y := x1, y1
+	for i := 0.0; i <= step; i++ {
+		tx, ty := math.Floor(mx), math.Floor(my)
+		s := e.Mget((int(tx)%128+128)%128, (int(ty)%128+128)%128)
+		if s != 0 {
+			sx := s%32*8 + int((mx-tx)*8)
+			sy := s/32*8 + int((my-ty)*8)
+			pxl := e.pget(float64(sx), float64(sy), buffBase, 256)
+			if !e.transparent(pxl, opt.TCol, buffBase) {
+				e.Pset(x, y, subPixels(opt.PFrom, opt.PTo, pxl))
+			}
+		}
+		x, y = x+dx, y+dy
+		mx, my = mx+mdx, my+mdy
+	}
+}
+
 // roundPxl rounds to the nearist pixel rather than the nearist number
 // number is the number to be rounded, size is the number of pixels
 func roundPxl(number, size float64) float64 {
@@ -66,39 +113,13 @@ func roundPxl(number, size float64) float64 {
 	return float64(num)
 }
 
-// tileInboundsX checks if the x coordiante is in screen bounds
-// sprite opts are taken into consideration
-func tileInboundsX(x int, opt SOp) bool {
-	w := int(float64(8*opt.W) * opt.SW)
-	return tileInbounds(x, 96, w, 0)
-}
-
-// tileInboundsX checks if the y coordiante is in screen bounds
-// sprite opts are taken into consideration
-func tileInboundsY(y int, opt SOp) bool {
-	h := int(float64(8*opt.H) * opt.SH)
-	return tileInbounds(96, y, 0, h)
-}
-
-// tileInbounds checks if the x and y coordinates are in screen bounds
-// w and h ensure the tiles that are partially off screen are still drawn
-func tileInbounds(x, y, w, h int) bool {
-	if x < -w || x > 192+w {
-		return false
-	}
-	if y < -h || y > 192+h {
-		return false
-	}
-	return true
-}
-
 // Mget gets the tile at the x, y coordinate on the map
 func (e *Engine) Mget(x, y int) int {
 	dex := x + y*128
 	shift := dex % 8
 	i := ((dex / 8) * 9) + shift
 	j := ((dex / 8) * 9) + 8
-	return int(e.RAM[mapBase-j])<<(shift+1)&0b100000000 | int(e.RAM[mapBase-i])
+	return int(e.RAM[MapBase-j])<<(shift+1)&0b100000000 | int(e.RAM[MapBase-i])
 }
 
 // Mset sets the tile at the x, y coordinate on the map
@@ -108,7 +129,7 @@ func (e *Engine) Mset(x, y, t int) {
 	i := ((dex / 8) * 9) + shift
 	j := ((dex / 8) * 9) + 8
 
-	e.RAM[mapBase-i] = byte(t)
-	e.RAM[mapBase-j] &= (0b00000001 << (7 - shift)) ^ 0b11111111
-	e.RAM[mapBase-j] |= byte(t>>1&0b10000000) >> shift
+	e.RAM[MapBase-i] = byte(t)
+	e.RAM[MapBase-j] &= (0b00000001 << (7 - shift)) ^ 0b11111111
+	e.RAM[MapBase-j] |= byte(t>>1&0b10000000) >> shift
 }
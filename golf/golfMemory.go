@@ -0,0 +1,90 @@
+package golf
+
+// inRAM checks that the l bytes starting at addr are all in RAM
+func (e *Engine) inRAM(addr, l int) bool {
+	return addr >= 0 && l >= 0 && addr+l <= len(e.RAM)
+}
+
+// Peek reads the byte at addr. Addresses outside of RAM return 0
+func (e *Engine) Peek(addr int) byte {
+	if !e.inRAM(addr, 1) {
+		return 0
+	}
+	return e.RAM[addr]
+}
+
+// Poke sets the byte at addr to val. Addresses outside of RAM are ignored
+func (e *Engine) Poke(addr int, val byte) {
+	if !e.inRAM(addr, 1) {
+		return
+	}
+	e.RAM[addr] = val
+}
+
+// Peek16 reads the 16 bit number starting at addr (e.g. CameraX)
+// signed is optional, signed numbers use the top bit as the sign bit
+func (e *Engine) Peek16(addr int, signed ...bool) int {
+	if !e.inRAM(addr, 2) {
+		return 0
+	}
+	s := len(signed) > 0 && signed[0]
+	return toInt(e.RAM[addr:addr+2], s)
+}
+
+// Poke16 sets the 16 bit number starting at addr to val
+// signed is optional, signed numbers use the top bit as the sign bit
+func (e *Engine) Poke16(addr, val int, signed ...bool) {
+	if !e.inRAM(addr, 2) {
+		return
+	}
+	s := len(signed) > 0 && signed[0]
+	copy(e.RAM[addr:addr+2], toBytes(val, 2, s))
+}
+
+// Peek24 reads the 24 bit number starting at addr (e.g. FrameCount)
+func (e *Engine) Peek24(addr int) int {
+	if !e.inRAM(addr, 3) {
+		return 0
+	}
+	return toInt(e.RAM[addr:addr+3], false)
+}
+
+// Memcpy copies l bytes from src to dest. The memory may overlap.
+// Any part of either range that is outside of RAM is not copied
+func (e *Engine) Memcpy(dest, src, l int) {
+	if dest < 0 {
+		src -= dest
+		l += dest
+		dest = 0
+	}
+	if src < 0 {
+		dest -= src
+		l += src
+		src = 0
+	}
+	if max := len(e.RAM) - dest; l > max {
+		l = max
+	}
+	if max := len(e.RAM) - src; l > max {
+		l = max
+	}
+	if l <= 0 {
+		return
+	}
+	copy(e.RAM[dest:dest+l], e.RAM[src:src+l])
+}
+
+// Memset sets l bytes starting at dest to val.
+// Any part of the range that is outside of RAM is not set
+func (e *Engine) Memset(dest int, val byte, l int) {
+	if dest < 0 {
+		l += dest
+		dest = 0
+	}
+	if max := len(e.RAM) - dest; l > max {
+		l = max
+	}
+	for i := dest; i < dest+l; i++ {
+		e.RAM[i] = val
+	}
+}
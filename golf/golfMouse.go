@@ -1,9 +1,5 @@
 package golf
 
-import (
-	"syscall/js"
-)
-
 //TODO change mouse dest location
 
 // MouseBtn is a mouse key
@@ -16,106 +12,97 @@ const (
 	RightClick  = MouseBtn(2)
 )
 
-func (e *Engine) initMouseListener(canvas js.Value) {
-	mouseMove := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
-		height := canvas.Get("offsetHeight").Int()
-		ratio := float64(ScreenHeight) / float64(height)
-		e.RAM[mouseX] = byte(args[0].Get("offsetX").Float() * ratio)
-		e.RAM[mouseY] = byte(args[0].Get("offsetY").Float() * ratio)
-
-		return nil
-	})
-	mouseDown := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
-		k := MouseBtn(args[0].Get("button").Int())
+// MoveMouse moves the mouse to the x, y screen coordinate,
+// backends use this to pass mouse events to the engine
+func (e *Engine) MoveMouse(x, y int) {
+	e.RAM[MouseX] = byte(x)
+	e.RAM[MouseY] = byte(y)
+}
 
-		if k == LeftClick {
-			btn := btnState(e.RAM[mouseBase] & 0b00000011)
-			if btn == unpressed {
-				e.RAM[mouseBase] |= byte(start)
-			}
+// PressMouse marks the mouse key as pressed,
+// backends use this to pass mouse events to the engine
+func (e *Engine) PressMouse(key MouseBtn) {
+	if key == LeftClick {
+		btn := btnState(e.RAM[MouseBase] & 0b00000011)
+		if btn == unpressed {
+			e.RAM[MouseBase] |= byte(start)
 		}
-		if k == MiddleClick {
-			btn := btnState((e.RAM[mouseBase] & 0b00001100) >> 2)
-			if btn == unpressed {
-				e.RAM[mouseBase] |= (byte(start) << 2)
-			}
+	}
+	if key == MiddleClick {
+		btn := btnState((e.RAM[MouseBase] & 0b00001100) >> 2)
+		if btn == unpressed {
+			e.RAM[MouseBase] |= (byte(start) << 2)
 		}
-		if k == RightClick {
-			btn := btnState((e.RAM[mouseBase] & 0b00110000) >> 4)
-			if btn == unpressed {
-				e.RAM[mouseBase] |= (byte(start) << 4)
-			}
+	}
+	if key == RightClick {
+		btn := btnState((e.RAM[MouseBase] & 0b00110000) >> 4)
+		if btn == unpressed {
+			e.RAM[MouseBase] |= (byte(start) << 4)
 		}
+	}
+}
 
-		return nil
-	})
-	mouseUp := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
-		k := MouseBtn(args[0].Get("button").Int())
-
-		if k == LeftClick {
-			btn := btnState(e.RAM[mouseBase] & 0b00000011)
-			if btn == pressed || btn == start {
-				e.RAM[mouseBase] &= 0b11111100
-				e.RAM[mouseBase] |= byte(end)
-			}
+// ReleaseMouse marks the mouse key as released,
+// backends use this to pass mouse events to the engine
+func (e *Engine) ReleaseMouse(key MouseBtn) {
+	if key == LeftClick {
+		btn := btnState(e.RAM[MouseBase] & 0b00000011)
+		if btn == pressed || btn == start {
+			e.RAM[MouseBase] &= 0b11111100
+			e.RAM[MouseBase] |= byte(end)
 		}
-		if k == MiddleClick {
-			btn := btnState((e.RAM[mouseBase] & 0b00001100) >> 2)
-			if btn == pressed || btn == start {
-				e.RAM[mouseBase] &= 0b11110011
-				e.RAM[mouseBase] |= (byte(end) << 2)
-			}
+	}
+	if key == MiddleClick {
+		btn := btnState((e.RAM[MouseBase] & 0b00001100) >> 2)
+		if btn == pressed || btn == start {
+			e.RAM[MouseBase] &= 0b11110011
+			e.RAM[MouseBase] |= (byte(end) << 2)
 		}
-		if k == RightClick {
-			btn := btnState((e.RAM[mouseBase] & 0b00110000) >> 4)
-			if btn == pressed || btn == start {
-				e.RAM[mouseBase] &= 0b11001111
-				e.RAM[mouseBase] |= (byte(end) << 4)
-			}
+	}
+	if key == RightClick {
+		btn := btnState((e.RAM[MouseBase] & 0b00110000) >> 4)
+		if btn == pressed || btn == start {
+			e.RAM[MouseBase] &= 0b11001111
+			e.RAM[MouseBase] |= (byte(end) << 4)
 		}
-
-		return nil
-	})
-	canvas.Call("addEventListener", "mousemove", mouseMove)
-	canvas.Call("addEventListener", "mousedown", mouseDown)
-	canvas.Call("addEventListener", "mouseup", mouseUp)
+	}
 }
 
 func (e *Engine) tickMouse() {
 	// Move btn from start to pressed
-	if btnState(e.RAM[mouseBase]&0b00000011) == start {
-		e.RAM[mouseBase] &= 0b11111100
-		e.RAM[mouseBase] |= byte(pressed)
+	if btnState(e.RAM[MouseBase]&0b00000011) == start {
+		e.RAM[MouseBase] &= 0b11111100
+		e.RAM[MouseBase] |= byte(pressed)
 	}
-	if btnState((e.RAM[mouseBase]&0b00001100)>>2) == start {
-		e.RAM[mouseBase] &= 0b11110011
-		e.RAM[mouseBase] |= (byte(pressed) << 2)
+	if btnState((e.RAM[MouseBase]&0b00001100)>>2) == start {
+		e.RAM[MouseBase] &= 0b11110011
+		e.RAM[MouseBase] |= (byte(pressed) << 2)
 	}
-	if btnState((e.RAM[mouseBase]&0b00110000)>>4) == start {
-		e.RAM[mouseBase] &= 0b11001111
-		e.RAM[mouseBase] |= (byte(pressed) << 4)
+	if btnState((e.RAM[MouseBase]&0b00110000)>>4) == start {
+		e.RAM[MouseBase] &= 0b11001111
+		e.RAM[MouseBase] |= (byte(pressed) << 4)
 	}
 
 	// Move from end to unpressed
-	if btnState(e.RAM[mouseBase]&0b00000011) == end {
-		e.RAM[mouseBase] &= 0b11111100
+	if btnState(e.RAM[MouseBase]&0b00000011) == end {
+		e.RAM[MouseBase] &= 0b11111100
 	}
-	if btnState((e.RAM[mouseBase]&0b00001100)>>2) == end {
-		e.RAM[mouseBase] &= 0b11110011
+	if btnState((e.RAM[MouseBase]&0b00001100)>>2) == end {
+		e.RAM[MouseBase] &= 0b11110011
 	}
-	if btnState((e.RAM[mouseBase]&0b00110000)>>4) == end {
-		e.RAM[mouseBase] &= 0b11001111
+	if btnState((e.RAM[MouseBase]&0b00110000)>>4) == end {
+		e.RAM[MouseBase] &= 0b11001111
 	}
 }
 
 // Mbtn returns true is the mouse key is being pressed
 func (e *Engine) Mbtn(key MouseBtn) bool {
-	btn := btnState(e.RAM[mouseBase] & 0b00000011)
+	btn := btnState(e.RAM[MouseBase] & 0b00000011)
 	if key == MiddleClick {
-		btn = btnState(e.RAM[mouseBase] & 0b00001100)
+		btn = btnState(e.RAM[MouseBase] & 0b00001100)
 	}
 	if key == RightClick {
-		btn = btnState(e.RAM[mouseBase] & 0b00110000)
+		btn = btnState(e.RAM[MouseBase] & 0b00110000)
 	}
 	if btn == start || btn == pressed {
 		return true
@@ -125,12 +112,12 @@ func (e *Engine) Mbtn(key MouseBtn) bool {
 
 // Mbtnp returns true if the mouse key was pressed this frame
 func (e *Engine) Mbtnp(key MouseBtn) bool {
-	btn := btnState(e.RAM[mouseBase] & 0b00000011)
+	btn := btnState(e.RAM[MouseBase] & 0b00000011)
 	if key == MiddleClick {
-		btn = btnState(e.RAM[mouseBase] & 0b00001100)
+		btn = btnState(e.RAM[MouseBase] & 0b00001100)
 	}
 	if key == RightClick {
-		btn = btnState(e.RAM[mouseBase] & 0b00110000)
+		btn = btnState(e.RAM[MouseBase] & 0b00110000)
 	}
 	if btn == start {
 		return true
@@ -140,12 +127,12 @@ func (e *Engine) Mbtnp(key MouseBtn) bool {
 
 // Mbtnr returns true if the mouse key was released this frame
 func (e *Engine) Mbtnr(key MouseBtn) bool {
-	btn := btnState(e.RAM[mouseBase] & 0b00000011)
+	btn := btnState(e.RAM[MouseBase] & 0b00000011)
 	if key == MiddleClick {
-		btn = btnState(e.RAM[mouseBase] & 0b00001100)
+		btn = btnState(e.RAM[MouseBase] & 0b00001100)
 	}
 	if key == RightClick {
-		btn = btnState(e.RAM[mouseBase] & 0b00110000)
+		btn = btnState(e.RAM[MouseBase] & 0b00110000)
 	}
 	if btn == end {
 		return true
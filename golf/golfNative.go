@@ -0,0 +1,10 @@
+//go:build !js
+// +build !js
+
+package golf
+
+// defaultBackend is used when EOp.Backend is not set,
+// outside the browser this is the headless backend
+func defaultBackend() Backend {
+	return NewHeadless()
+}
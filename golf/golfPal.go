@@ -0,0 +1,42 @@
+package golf
+
+// Pal maps the color from to the color to for all future draw calls
+// (Cls, Pset, shapes, sprites, the map and text). Use RPal to reset the draw pallet
+func (e *Engine) Pal(from, to Col) {
+	e.RAM[DrawPal+int(from&0b00000111)] = byte(to)
+}
+
+// PalTable maps each color n to table[n] for all future draw calls.
+// e.g. a table of all Col7 will flash everything white
+func (e *Engine) PalTable(table [8]Col) {
+	for i, c := range table {
+		e.RAM[DrawPal+i] = byte(c)
+	}
+}
+
+// Palt sets whether col is transparent when drawing sprites and the map.
+// Several colors can be transparent at once, this is in addition to SOp.TCol
+func (e *Engine) Palt(col Col, t bool) {
+	bit := byte(1) << (col & 0b00000111)
+	e.RAM[DrawPalT] &= bit ^ 0b11111111
+	if t {
+		e.RAM[DrawPalT] |= bit
+	}
+}
+
+// RPal resets the draw pallet and makes all colors opaque again
+func (e *Engine) RPal() {
+	for i := 0; i < 8; i++ {
+		e.RAM[DrawPal+i] = 0
+	}
+	e.RAM[DrawPalT] = 0
+}
+
+// drawCol maps col through the draw pallet, 0 is used when a color is not mapped
+func (e *Engine) drawCol(col Col) Col {
+	to := e.RAM[DrawPal+int(col&0b00000111)]
+	if to == 0 {
+		return col
+	}
+	return Col(to)
+}
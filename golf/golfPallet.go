@@ -0,0 +1,17 @@
+package golf
+
+import imgcolor "image/color"
+
+type rgb struct {
+	r, g, b uint8
+}
+
+// toRGBA converts the rgb color into an opaque RGBA color
+func (c rgb) toRGBA() imgcolor.RGBA {
+	return imgcolor.RGBA{c.r, c.g, c.b, 255}
+}
+
+type pallet [4]rgb
+
+// pallets is the GoLF color pallet, generated by the colors tool
+var pallets = [16]pallet{pallet{{0, 0, 0}, {96, 96, 96}, {144, 144, 144}, {192, 192, 192}}, pallet{{64, 64, 64}, {128, 128, 128}, {160, 160, 160}, {255, 255, 255}}, pallet{{21, 3, 7}, {74, 18, 21}, {174, 48, 49}, {236, 126, 124}}, pallet{{48, 11, 14}, {126, 33, 35}, {230, 63, 63}, {242, 189, 184}}, pallet{{22, 6, 2}, {72, 44, 11}, {220, 155, 35}, {235, 185, 81}}, pallet{{47, 25, 7}, {121, 81, 19}, {228, 170, 58}, {250, 215, 126}}, pallet{{25, 21, 0}, {155, 90, 16}, {210, 118, 20}, {242, 178, 123}}, pallet{{2, 24, 12}, {74, 102, 61}, {124, 153, 100}, {178, 203, 144}}, pallet{{50, 76, 45}, {97, 128, 77}, {151, 178, 122}, {205, 227, 166}}, pallet{{7, 13, 17}, {58, 119, 126}, {108, 225, 234}, {148, 232, 230}}, pallet{{33, 66, 72}, {83, 172, 180}, {128, 229, 232}, {188, 238, 226}}, pallet{{7, 1, 26}, {21, 34, 83}, {35, 66, 165}, {59, 104, 191}}, pallet{{14, 18, 55}, {28, 50, 111}, {47, 85, 165}, {82, 141, 242}}, pallet{{21, 34, 83}, {70, 135, 143}, {148, 227, 68}, {226, 243, 228}}, pallet{{0, 48, 59}, {255, 119, 119}, {255, 206, 150}, {241, 242, 218}}, pallet{{0, 0, 0}, {197, 17, 17}, {20, 58, 133}, {255, 255, 255}}}
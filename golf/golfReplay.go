@@ -0,0 +1,245 @@
+package golf
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"time"
+)
+
+// inputBase is the start of the input state in RAM (mouse x, y, buttons and the keyboard)
+const inputBase = MouseX
+
+// inputSize is the number of input bytes saved for each frame
+const inputSize = KeyBase + 0x37 - inputBase
+
+// mouseBtnMask masks out the mouse style bits which are set by the game, not the player
+const mouseBtnMask = 0b00111111
+
+// replayMagic and replayVersion start every replay file
+const (
+	replayMagic   = "GLFR"
+	replayVersion = 1
+)
+
+// Replay is a recording of the input state for each frame of a game session
+type Replay struct {
+	// Seed is the RNG seed used while recording
+	Seed int64
+
+	input   [][inputSize]byte
+	screens []uint32
+}
+
+// Len is the number of frames in the replay
+func (r *Replay) Len() int {
+	return len(r.input)
+}
+
+// replayState tracks the recording or playing replay
+type replayState struct {
+	recording *Replay
+	playing   *Replay
+	frame     int
+	mismatch  int
+	inFrame   bool // true if this frame's input came from or went to the replay
+}
+
+// Record seeds the RNG and starts recording input from the next frame
+func (e *Engine) Record() {
+	seed := time.Now().UnixNano()
+	e.Srand(seed)
+	e.replay = replayState{recording: &Replay{Seed: seed}, mismatch: -1}
+}
+
+// StopRecord stops recording and returns the recorded replay. If it's called
+// from Update or Draw the current frame is left out because it hasn't been drawn yet
+func (e *Engine) StopRecord() *Replay {
+	r := e.replay.recording
+	e.replay.recording = nil
+	if r != nil && len(r.input) > len(r.screens) {
+		r.input = r.input[:len(r.screens)]
+	}
+	return r
+}
+
+// Play seeds the RNG with the replay seed and replaces the
+// player's input with the replay's input starting from the next frame.
+// Each frame's screen buffer is compared with the recorded screen buffer.
+// An empty replay is ignored
+func (e *Engine) Play(r *Replay) {
+	if r == nil || r.Len() == 0 {
+		return
+	}
+	e.Srand(r.Seed)
+	e.replay = replayState{playing: r, mismatch: -1}
+}
+
+// Playing returns true while a replay is being played
+func (e *Engine) Playing() bool {
+	return e.replay.playing != nil
+}
+
+// ReplayMismatch returns the first frame of the last played replay
+// where the screen buffer didn't match the recording
+func (e *Engine) ReplayMismatch() (int, bool) {
+	return e.replay.mismatch, e.replay.mismatch >= 0
+}
+
+// replaying returns true while recording or playing, frame skipping
+// is turned off so that every frame of the session is drawn
+func (e *Engine) replaying() bool {
+	return e.replay.recording != nil || e.replay.playing != nil
+}
+
+// replayInput records the current input or overwrites it with the replay input
+func (e *Engine) replayInput() {
+	e.replay.inFrame = e.replaying()
+	if r := e.replay.recording; r != nil {
+		in := [inputSize]byte{}
+		copy(in[:], e.RAM[inputBase:inputBase+inputSize])
+		in[MouseBase-inputBase] &= mouseBtnMask
+		r.input = append(r.input, in)
+	}
+
+	if r := e.replay.playing; r != nil {
+		in := r.input[e.replay.frame]
+		style := e.RAM[MouseBase] &^ mouseBtnMask
+		copy(e.RAM[inputBase:inputBase+inputSize], in[:])
+		e.RAM[MouseBase] |= style
+	}
+}
+
+// replayScreen records the screen buffer checksum or checks it against the replay
+func (e *Engine) replayScreen() {
+	if !e.replay.inFrame {
+		return
+	}
+	sum := crc32.ChecksumIEEE(e.RAM[:ScreenPalSet+1])
+	if r := e.replay.recording; r != nil && len(r.screens) < len(r.input) {
+		r.screens = append(r.screens, sum)
+	}
+
+	if r := e.replay.playing; r != nil {
+		if sum != r.screens[e.replay.frame] && e.replay.mismatch < 0 {
+			e.replay.mismatch = e.replay.frame
+		}
+		e.replay.frame++
+		if e.replay.frame >= len(r.input) {
+			e.replay.playing = nil
+		}
+	}
+}
+
+// Save writes the replay to w. Only frames where the input changes are stored
+func (r *Replay) Save(w io.Writer) error {
+	buf := bufio.NewWriter(w)
+	buf.WriteString(replayMagic)
+	buf.WriteByte(replayVersion)
+
+	num := make([]byte, binary.MaxVarintLen64)
+	binary.Write(buf, binary.BigEndian, r.Seed)
+	buf.Write(num[:binary.PutUvarint(num, uint64(len(r.input)))])
+
+	// each block is a count of unchanged frames followed by
+	// a bit mask of the changed bytes and the changed bytes
+	prev := [inputSize]byte{}
+	for i := 0; i < len(r.input); {
+		run := 0
+		for i < len(r.input) && r.input[i] == prev {
+			run++
+			i++
+		}
+		buf.Write(num[:binary.PutUvarint(num, uint64(run))])
+		if i == len(r.input) {
+			break
+		}
+
+		mask := [8]byte{}
+		changed := []byte{}
+		for j, b := range r.input[i] {
+			if b != prev[j] {
+				mask[j/8] |= 0b10000000 >> (j % 8)
+				changed = append(changed, b)
+			}
+		}
+		buf.Write(mask[:])
+		buf.Write(changed)
+		prev = r.input[i]
+		i++
+	}
+
+	for _, sum := range r.screens {
+		binary.Write(buf, binary.BigEndian, sum)
+	}
+
+	return buf.Flush()
+}
+
+// LoadReplay reads a replay written by Replay.Save
+func LoadReplay(rd io.Reader) (*Replay, error) {
+	buf := bufio.NewReader(rd)
+	head := make([]byte, len(replayMagic)+1)
+	if _, err := io.ReadFull(buf, head); err != nil {
+		return nil, err
+	}
+	if string(head[:len(replayMagic)]) != replayMagic {
+		return nil, errors.New("not a golf replay file")
+	}
+	if head[len(replayMagic)] != replayVersion {
+		return nil, errors.New("unsupported golf replay version")
+	}
+
+	r := &Replay{}
+	if err := binary.Read(buf, binary.BigEndian, &r.Seed); err != nil {
+		return nil, err
+	}
+	n, err := binary.ReadUvarint(buf)
+	if err != nil {
+		return nil, err
+	}
+
+	prev := [inputSize]byte{}
+	for uint64(len(r.input)) < n {
+		run, err := binary.ReadUvarint(buf)
+		if err != nil {
+			return nil, err
+		}
+		if uint64(len(r.input))+run > n {
+			return nil, errors.New("corrupted golf replay file")
+		}
+		for i := uint64(0); i < run; i++ {
+			r.input = append(r.input, prev)
+		}
+		if uint64(len(r.input)) == n {
+			break
+		}
+
+		mask := [8]byte{}
+		if _, err := io.ReadFull(buf, mask[:]); err != nil {
+			return nil, err
+		}
+		for j := range prev {
+			if mask[j/8]&(0b10000000>>(j%8)) == 0 {
+				continue
+			}
+			b, err := buf.ReadByte()
+			if err != nil {
+				return nil, err
+			}
+			prev[j] = b
+		}
+		r.input = append(r.input, prev)
+	}
+
+	r.screens = make([]uint32, n)
+	for i := range r.screens {
+		if err := binary.Read(buf, binary.BigEndian, &r.screens[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
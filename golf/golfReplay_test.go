@@ -0,0 +1,150 @@
+package golf
+
+import (
+	"bytes"
+	"testing"
+)
+
+// replayGame is a small game that moves a box with the arrow keys and the RNG
+type replayGame struct {
+	e    *Engine
+	h    *Headless
+	x, y float64
+
+	// onUpdate is called at the start of each update
+	onUpdate func(frame int)
+}
+
+func newReplayGame() *replayGame {
+	g := &replayGame{h: NewHeadless()}
+	g.e = newTestEngine(g.h)
+	g.e.Update = func() {
+		if g.onUpdate != nil {
+			g.onUpdate(g.e.Frames())
+		}
+		if g.e.Btn(RightArrow) {
+			g.x += 1 + g.e.Rnd(2)
+		}
+		if g.e.Btn(DownArrow) {
+			g.y++
+		}
+	}
+	g.e.Draw = func() {
+		g.e.Cls(Col0)
+		g.e.RectFill(g.x, g.y, 4, 4, Col3)
+	}
+	g.e.Run()
+	return g
+}
+
+// saveLoad round trips the replay through Save and LoadReplay
+func saveLoad(t *testing.T, r *Replay) *Replay {
+	buf := &bytes.Buffer{}
+	if err := r.Save(buf); err != nil {
+		t.Fatal(err)
+	}
+	ret, err := LoadReplay(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ret
+}
+
+func TestReplayRoundTrip(t *testing.T) {
+	g := newReplayGame()
+	g.e.Record()
+	g.h.Step(5)
+	g.e.PressKey(RightArrow)
+	g.h.Step(20)
+	g.e.PressKey(DownArrow)
+	g.h.Step(3)
+	g.e.ReleaseKey(RightArrow)
+	g.h.Step(10)
+	g.e.ReleaseKey(DownArrow)
+	g.h.Step(2)
+	r := g.e.StopRecord()
+	if r.Len() != 40 {
+		t.Fatalf("recorded %d frames, want 40", r.Len())
+	}
+	loaded := saveLoad(t, r)
+	if loaded.Len() != r.Len() || loaded.Seed != r.Seed {
+		t.Fatalf("loaded %d frames with seed %d, saved %d with seed %d", loaded.Len(), loaded.Seed, r.Len(), r.Seed)
+	}
+
+	// play the replay on a new engine
+	p := newReplayGame()
+	p.e.Play(loaded)
+	if !p.e.Playing() {
+		t.Fatal("the replay isn't playing")
+	}
+	p.h.Step(40)
+	if p.e.Playing() {
+		t.Fatal("the replay is still playing after the last frame")
+	}
+	if f, ok := p.e.ReplayMismatch(); ok {
+		t.Fatalf("the replay didn't match on frame %d", f)
+	}
+	if p.x != g.x || p.y != g.y {
+		t.Fatalf("the replay ended at %v, %v, the recording ended at %v, %v", p.x, p.y, g.x, g.y)
+	}
+	if !bytes.Equal(p.h.Screen, g.h.Screen) {
+		t.Fatal("the replay's last screen doesn't match the recording")
+	}
+}
+
+func TestReplayMismatch(t *testing.T) {
+	g := newReplayGame()
+	g.e.Record()
+	g.e.PressKey(DownArrow)
+	g.h.Step(10)
+	r := g.e.StopRecord()
+
+	// start the box somewhere else so the screens don't match
+	p := newReplayGame()
+	p.x = 50
+	p.e.Play(r)
+	p.h.Step(10)
+	if f, ok := p.e.ReplayMismatch(); !ok || f != 0 {
+		t.Fatalf("ReplayMismatch got %d, %v, want 0, true", f, ok)
+	}
+}
+
+func TestReplayStopInUpdate(t *testing.T) {
+	g := newReplayGame()
+	var r *Replay
+	g.onUpdate = func(frame int) {
+		switch frame {
+		case 1:
+			g.e.Record()
+		case 5:
+			r = g.e.StopRecord()
+		}
+	}
+	g.e.PressKey(RightArrow)
+	g.h.Step(8)
+
+	// frames 2, 3 and 4 are recorded, frame 5 stopped before it was drawn
+	if r.Len() != 3 || len(r.screens) != 3 {
+		t.Fatalf("recorded %d frames and %d screens, want 3", r.Len(), len(r.screens))
+	}
+	loaded := saveLoad(t, r)
+
+	p := newReplayGame()
+	p.h.Step(1)
+	p.e.Play(loaded)
+	p.h.Step(5)
+	if p.e.Playing() {
+		t.Fatal("the replay is still playing after the last frame")
+	}
+}
+
+func TestPlayEmpty(t *testing.T) {
+	g := newReplayGame()
+	g.e.Record()
+	g.e.Play(g.e.StopRecord())
+	g.e.Play(nil)
+	g.h.Step(2)
+	if g.e.Playing() {
+		t.Fatal("an empty replay is playing")
+	}
+}
@@ -0,0 +1,21 @@
+package golf
+
+import (
+	"math/rand"
+)
+
+// Srand seeds the engine's random number generator
+func (e *Engine) Srand(seed int64) {
+	e.rng.Seed(seed)
+}
+
+// Rnd returns a random number n where 0 <= n < max.
+// Use this rather than math/rand so replays are deterministic
+func (e *Engine) Rnd(max float64) float64 {
+	return e.rng.Float64() * max
+}
+
+// newRng creates a random number generator seeded with seed
+func newRng(seed int64) *rand.Rand {
+	return rand.New(rand.NewSource(seed))
+}
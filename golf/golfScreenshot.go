@@ -0,0 +1,51 @@
+package golf
+
+import (
+	"image"
+	imgcolor "image/color"
+	"image/png"
+	"io"
+)
+
+// golfPalette is the full 64 color GoLF pallet, color n
+// of pallet p is at index p*4+n
+var golfPalette = func() imgcolor.Palette {
+	ret := imgcolor.Palette{}
+	for _, p := range pallets {
+		for _, c := range p {
+			ret = append(ret, c.toRGBA())
+		}
+	}
+	return ret
+}()
+
+// Screenshot returns the current screen buffer as an image.
+// The image uses the full 64 color GoLF pallet and includes
+// the display pallet effects (fades, cycles and scanline pallets)
+func (e *Engine) Screenshot() *image.Paletted {
+	pal := make([]byte, ScreenHeight*8)
+	e.displayPallets(pal)
+	w, h := e.ScreenSize()
+	return screenImage(e.RAM[:ScreenPalSet+1], pal, w, h)
+}
+
+// ScreenshotPNG writes the current screen buffer to w as a png
+// scale is the integer amount to scale each pixel by
+func (e *Engine) ScreenshotPNG(w io.Writer, scale int) error {
+	return png.Encode(w, scaleImage(e.Screenshot(), scale))
+}
+
+// scaleImage scales the image by an integer amount using nearest neighbor scaling
+func scaleImage(img *image.Paletted, scale int) *image.Paletted {
+	if scale <= 1 {
+		return img
+	}
+	b := img.Bounds()
+	ret := image.NewPaletted(image.Rect(0, 0, b.Dx()*scale, b.Dy()*scale), img.Palette)
+	for y := 0; y < ret.Rect.Dy(); y++ {
+		for x := 0; x < ret.Rect.Dx(); x++ {
+			ret.Pix[x+y*ret.Stride] = img.Pix[x/scale+(y/scale)*img.Stride]
+		}
+	}
+	return ret
+}
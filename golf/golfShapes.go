@@ -0,0 +1,239 @@
+package golf
+
+import (
+	"math"
+	"sort"
+)
+
+// Tri draws a triangle outline
+func (e *Engine) Tri(x1, y1, x2, y2, x3, y3 float64, col Col, fixed ...bool) {
+	e.Poly([]float64{x1, y1, x2, y2, x3, y3}, col, fixed...)
+}
+
+// TriFill draws a filled triangle
+func (e *Engine) TriFill(x1, y1, x2, y2, x3, y3 float64, col Col, fixed ...bool) {
+	e.PolyFill([]float64{x1, y1, x2, y2, x3, y3}, col, fixed...)
+}
+
+// Poly draws a closed polygon outline. points is a list of x, y pairs
+// e.g. []float64{x1, y1, x2, y2, x3, y3}
+func (e *Engine) Poly(points []float64, col Col, fixed ...bool) {
+	n := len(points) / 2
+	for i := 0; i < n; i++ {
+		j := (i + 1) % n
+		e.Line(points[i*2], points[i*2+1], points[j*2], points[j*2+1], col, fixed...)
+	}
+	if n == 1 {
+		e.Line(points[0], points[1], points[0]+1, points[1], col, fixed...)
+	}
+}
+
+// PolyFill draws a filled polygon. points is a list of x, y pairs.
+// The polygon can be convex or concave, overlapping areas use the even-odd rule
+func (e *Engine) PolyFill(points []float64, col Col, fixed ...bool) {
+	n := len(points) / 2
+	if n < 3 {
+		e.Poly(points, col, fixed...)
+		return
+	}
+	f := false
+	if len(fixed) > 0 {
+		f = fixed[0]
+	}
+	cx, cy := 0.0, 0.0
+	if !f {
+		cx = toFloat(e.RAM[CameraX:CameraX+2], true)
+		cy = toFloat(e.RAM[CameraY:CameraY+2], true)
+	}
+
+	xs, ys := make([]float64, n), make([]float64, n)
+	minY, maxY := math.Inf(1), math.Inf(-1)
+	for i := 0; i < n; i++ {
+		xs[i], ys[i] = points[i*2]-cx, points[i*2+1]-cy
+		minY, maxY = math.Min(minY, ys[i]), math.Max(maxY, ys[i])
+	}
+
+	cv := e.canvas(col)
+
+	// only scan the rows that can be drawn
+	_, clipY, _, clipH := e.viewClip()
+	top := math.Max(math.Floor(minY), float64(clipY))
+	bottom := math.Min(math.Ceil(maxY), float64(clipY+clipH))
+
+	cross := []float64{}
+	for y := top; y < bottom; y++ {
+		// sample each row at the pixel center
+		sy := y + 0.5
+		cross = cross[:0]
+		for i := 0; i < n; i++ {
+			j := (i + 1) % n
+			y1, y2 := ys[i], ys[j]
+			if (y1 <= sy) == (y2 <= sy) {
+				continue
+			}
+			cross = append(cross, xs[i]+(sy-y1)/(y2-y1)*(xs[j]-xs[i]))
+		}
+		sort.Float64s(cross)
+		for i := 0; i+1 < len(cross); i += 2 {
+			cv.hspan(math.Ceil(cross[i]-0.5), math.Ceil(cross[i+1]-0.5)-1, y)
+		}
+	}
+}
+
+// Oval draws an ellipse outline with center at xc, yc and radii rx and ry
+func (e *Engine) Oval(xc, yc, rx, ry float64, col Col, fixed ...bool) {
+	f := false
+	if len(fixed) > 0 {
+		f = fixed[0]
+	}
+	e.oval(xc, yc, rx, ry, col, false, f)
+}
+
+// OvalFill draws a filled ellipse with center at xc, yc and radii rx and ry
+func (e *Engine) OvalFill(xc, yc, rx, ry float64, col Col, fixed ...bool) {
+	f := false
+	if len(fixed) > 0 {
+		f = fixed[0]
+	}
+	e.oval(xc, yc, rx, ry, col, true, f)
+}
+
+// oval draws an ellipse using the midpoint ellipse algorithm
+func (e *Engine) oval(xc, yc, rx, ry float64, c Col, filled bool, fixed bool) {
+	if !fixed {
+		xc -= toFloat(e.RAM[CameraX:CameraX+2], true)
+		yc -= toFloat(e.RAM[CameraY:CameraY+2], true)
+	}
+	rx, ry = math.Round(math.Abs(rx)), math.Round(math.Abs(ry))
+	cv := e.canvas(c)
+	plot4 := func(x, y float64) {
+		if filled {
+			cv.hspan(xc-x, xc+x, yc+y)
+			cv.hspan(xc-x, xc+x, yc-y)
+			return
+		}
+		cv.pset(xc+x, yc+y, cv.col)
+		cv.pset(xc-x, yc+y, cv.col)
+		cv.pset(xc+x, yc-y, cv.col)
+		cv.pset(xc-x, yc-y, cv.col)
+	}
+	if ry == 0 {
+		plot4(rx, 0)
+		if filled || rx == 0 {
+			return
+		}
+		cv.hspan(xc-rx, xc+rx, yc)
+		return
+	}
+
+	rx2, ry2 := rx*rx, ry*ry
+	x, y := 0.0, ry
+	dx, dy := 0.0, 2*rx2*y
+
+	// region 1, the slope is less than 1
+	d := ry2 - rx2*ry + rx2/4
+	for dx < dy {
+		plot4(x, y)
+		x++
+		dx += 2 * ry2
+		if d < 0 {
+			d += dx + ry2
+		} else {
+			y--
+			dy -= 2 * rx2
+			d += dx - dy + ry2
+		}
+	}
+
+	// region 2, the slope is greater than 1
+	d = ry2*(x+0.5)*(x+0.5) + rx2*(y-1)*(y-1) - rx2*ry2
+	for y >= 0 {
+		plot4(x, y)
+		y--
+		dy -= 2 * rx2
+		if d > 0 {
+			d += rx2 - dy
+		} else {
+			x++
+			dx += 2 * ry2
+			d += dx - dy + rx2
+		}
+	}
+}
+
+// RRect draws a rectangle border with rounded corners of radius r
+func (e *Engine) RRect(x, y, w, h, r float64, col Col, fixed ...bool) {
+	f := false
+	if len(fixed) > 0 {
+		f = fixed[0]
+	}
+	e.rrect(x, y, w, h, r, col, false, f)
+}
+
+// RRectFill draws a filled rectangle with rounded corners of radius r
+func (e *Engine) RRectFill(x, y, w, h, r float64, col Col, fixed ...bool) {
+	f := false
+	if len(fixed) > 0 {
+		f = fixed[0]
+	}
+	e.rrect(x, y, w, h, r, col, true, f)
+}
+
+// rrect draws a rounded rectangle, the corners are drawn using Bresenham's algorithm
+func (e *Engine) rrect(x, y, w, h, r float64, c Col, filled bool, fixed bool) {
+	if !fixed {
+		x -= toFloat(e.RAM[CameraX:CameraX+2], true)
+		y -= toFloat(e.RAM[CameraY:CameraY+2], true)
+	}
+	r = math.Floor(math.Min(r, math.Min(w, h)/2-0.5))
+	if r <= 0 {
+		if filled {
+			e.RectFill(x, y, w, h, c, true)
+			return
+		}
+		e.Rect(x, y, w, h, c, true)
+		return
+	}
+
+	// the corner circle centers
+	left, right := x+r, x+w-1-r
+	top, bottom := y+r, y+h-1-r
+
+	cv := e.canvas(c)
+	if filled {
+		for row := top; row <= bottom; row++ {
+			cv.hspan(x, x+w-1, row)
+		}
+	} else {
+		e.Line(left, y, right+1, y, c, true)
+		e.Line(left, y+h-1, right+1, y+h-1, c, true)
+		e.Line(x, top, x, bottom+1, c, true)
+		e.Line(x+w-1, top, x+w-1, bottom+1, c, true)
+	}
+
+	corner := func(px, py float64) {
+		if filled {
+			cv.hspan(left-px, right+px, top-py)
+			cv.hspan(left-px, right+px, bottom+py)
+			return
+		}
+		cv.pset(left-px, top-py, cv.col)
+		cv.pset(right+px, top-py, cv.col)
+		cv.pset(left-px, bottom+py, cv.col)
+		cv.pset(right+px, bottom+py, cv.col)
+	}
+
+	px, py := 0.0, r
+	d := 3 - 2*r
+	for py >= px {
+		corner(px, py)
+		corner(py, px)
+		px++
+		if d > 0 {
+			py--
+			d = d + 4*(px-py) + 10
+		} else {
+			d = d + 4*px + 6
+		}
+	}
+}
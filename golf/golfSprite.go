@@ -6,7 +6,7 @@ import (
 
 // LoadSprs loads the sprite sheet into memory
 func (e *Engine) LoadSprs(sheet [0x3000]byte) {
-	base := spriteBase
+	base := SpriteBase
 	for i, b := range sheet {
 		e.RAM[i+base] = b
 	}
@@ -14,7 +14,7 @@ func (e *Engine) LoadSprs(sheet [0x3000]byte) {
 
 // LoadFlags load the sprite flags into memory
 func (e *Engine) LoadFlags(flags [0x200]byte) {
-	base := spriteFlags
+	base := SpriteFlags
 	for i, b := range flags {
 		e.RAM[i+base] = b
 	}
@@ -22,8 +22,8 @@ func (e *Engine) LoadFlags(flags [0x200]byte) {
 
 func (e *Engine) setActiveSpriteBuff(colAddr int) {
 	c := toBytes(colAddr, 2, false)
-	e.RAM[activeSpriteBuff] = c[0]
-	e.RAM[activeSpriteBuff+1] = c[1]
+	e.RAM[ActiveSpriteBuff] = c[0]
+	e.RAM[ActiveSpriteBuff+1] = c[1]
 }
 
 // SOp additional options for drawing sprites
@@ -66,8 +66,8 @@ func (e *Engine) SSpr(sx, sy, sw, sh int, dx, dy float64, opts ...SOp) {
 		opt = opts[0]
 	}
 	if !opt.Fixed {
-		dx -= toFloat(e.RAM[cameraX:cameraX+2], true)
-		dy -= toFloat(e.RAM[cameraY:cameraY+2], true)
+		dx -= toFloat(e.RAM[CameraX:CameraX+2], true)
+		dy -= toFloat(e.RAM[CameraY:CameraY+2], true)
 	}
 	if opt.SH == 0 {
 		opt.SH = 1
@@ -75,31 +75,108 @@ func (e *Engine) SSpr(sx, sy, sw, sh int, dx, dy float64, opts ...SOp) {
 	if opt.SW == 0 {
 		opt.SW = 1
 	}
-	buffBase := toInt(e.RAM[activeSpriteBuff:activeSpriteBuff+2], false)
-
-	for x := 0; x < sw; x++ {
-		for y := 0; y < sh; y++ {
-			pxl := e.pget(float64(sx+x), float64(sy+y), buffBase, 256)
-			if pxl != opt.TCol {
-				pxl = subPixels(opt.PFrom, opt.PTo, pxl)
-				fx := 0
-				if opt.FH {
-					fx = int(float64(sw) * opt.SW)
-				}
-				fy := 0
-				if opt.FV {
-					fy = int(float64(sh) * opt.SH)
-				}
-				for scaleX := int(float64(x) * opt.SW); scaleX < int(float64(x+1)*opt.SW); scaleX++ {
-					for scaleY := int(float64(y) * opt.SH); scaleY < int(float64(y+1)*opt.SH); scaleY++ {
-						e.Pset(dx+math.Abs(float64(fx-scaleX)), dy+math.Abs(float64(fy-scaleY)), pxl)
-					}
-				}
+	e.blit(sx, sy, sw, sh, dx, dy, opt)
+}
+
+// RSpr draws 8x8 sprite n from the sprite sheet rotated by angle radians (clockwise)
+// x, y is where the top left of the unrotated sprite is drawn and px, py
+// is the pivot point to rotate around, relative to the top left of the sprite
+func (e *Engine) RSpr(n int, x, y, angle, px, py float64, opts ...SOp) {
+	sx := n % 32
+	sy := n / 32
+	opt := SOp{}
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	w := 1
+	h := 1
+	if opt.H > 1 {
+		h = opt.H
+	}
+	if opt.W > 1 {
+		w = opt.W
+	}
+	e.RSSpr(sx*8, sy*8, w*8, h*8, x, y, angle, px, py, opt)
+}
+
+// RSSpr draw a rect from the sprite sheet rotated by angle radians (clockwise)
+// sx, sy, sw, and sh define the rect on the sprite sheet
+// dx, dy is where the top left of the unrotated rect is drawn
+// px, py is the pivot point to rotate around, relative to the top left of the rect
+func (e *Engine) RSSpr(sx, sy, sw, sh int, dx, dy, angle, px, py float64, opts ...SOp) {
+	opt := SOp{}
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	if !opt.Fixed {
+		dx -= toFloat(e.RAM[CameraX:CameraX+2], true)
+		dy -= toFloat(e.RAM[CameraY:CameraY+2], true)
+	}
+	if opt.SH == 0 {
+		opt.SH = 1
+	}
+	if opt.SW == 0 {
+		opt.SW = 1
+	}
+	buffBase := toInt(e.RAM[ActiveSpriteBuff:ActiveSpriteBuff+2], false)
+
+	// the pivot point on the screen
+	pivX, pivY := dx+px*opt.SW, dy+py*opt.SH
+	sin, cos := math.Sincos(angle)
+
+	// find the screen bounds of the rotated rect
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, c := range [4][2]float64{{0, 0}, {1, 0}, {0, 1}, {1, 1}} {
+		cx := c[0]*float64(sw)*opt.SW - px*opt.SW
+		cy := c[1]*float64(sh)*opt.SH - py*opt.SH
+		x := pivX + cx*cos - cy*sin
+		y := pivY + cx*sin + cy*cos
+		minX, maxX = math.Min(minX, x), math.Max(maxX, x)
+		minY, maxY = math.Min(minY, y), math.Max(maxY, y)
+	}
+	clipX, clipY, clipW, clipH := e.viewClip()
+	minX, minY = math.Max(math.Floor(minX), float64(clipX)), math.Max(math.Floor(minY), float64(clipY))
+	maxX, maxY = math.Min(math.Ceil(maxX), float64(clipX+clipW)), math.Min(math.Ceil(maxY), float64(clipY+clipH))
+
+	// sample the sprite for each screen pixel by rotating it back
+	for y := minY; y < maxY; y++ {
+		for x := minX; x < maxX; x++ {
+			rx, ry := x+0.5-pivX, y+0.5-pivY
+			u := (rx*cos+ry*sin)/opt.SW + px
+			v := (-rx*sin+ry*cos)/opt.SH + py
+			if u < 0 || v < 0 || u >= float64(sw) || v >= float64(sh) {
+				continue
+			}
+			ix, iy := int(u), int(v)
+			if opt.FH {
+				ix = sw - 1 - ix
+			}
+			if opt.FV {
+				iy = sh - 1 - iy
+			}
+
+			pxl := e.pget(float64(sx+ix), float64(sy+iy), buffBase, 256)
+			if !e.transparent(pxl, opt.TCol, buffBase) {
+				e.Pset(x, y, subPixels(opt.PFrom, opt.PTo, pxl))
 			}
 		}
 	}
 }
 
+// transparent checks if the sprite pixel should not be drawn. The pixel is transparent
+// if it's tCol or if it's set as transparent with Palt. Palt is not used for the
+// internal sprite sheet so text and the mouse are always drawn
+func (e *Engine) transparent(pxl, tCol Col, buffBase int) bool {
+	if pxl == tCol {
+		return true
+	}
+	if buffBase == InternalSpriteBase || buffBase == InternalFontBase {
+		return false
+	}
+	return e.RAM[DrawPalT]&(1<<(pxl&0b00000111)) > 0
+}
+
 // subPixels is used to swap pixels based on a pallet swap
 func subPixels(palFrom, palTo []Col, col Col) Col {
 	if len(palFrom) == 0 {
@@ -115,23 +192,23 @@ func subPixels(palFrom, palTo []Col, col Col) Col {
 
 // Fget gets the fth flag on the nth sprite
 func (e *Engine) Fget(n, f int) bool {
-	return e.RAM[spriteFlags+n]&(0b10000000>>f) > 0
+	return e.RAM[SpriteFlags+n]&(0b10000000>>f) > 0
 }
 
 // FgetByte gets the byte flag on the nth sprite
 func (e *Engine) FgetByte(n int) byte {
-	return e.RAM[spriteFlags+n]
+	return e.RAM[SpriteFlags+n]
 }
 
 // Fset sets the fth flag on the nth sprite to s
 func (e *Engine) Fset(n, f int, s bool) {
-	e.RAM[spriteFlags+n] &= (0b10000001>>f ^ 0b11111111)
+	e.RAM[SpriteFlags+n] &= (0b10000001>>f ^ 0b11111111)
 	if s {
-		e.RAM[spriteFlags+n] |= (0b10000000 >> f)
+		e.RAM[SpriteFlags+n] |= (0b10000000 >> f)
 	}
 }
 
 // FsetByte sets the byte flag on the nth sprite
 func (e *Engine) FsetByte(n int, b byte) {
-	e.RAM[spriteFlags+n] = b
+	e.RAM[SpriteFlags+n] = b
 }
@@ -38,6 +38,12 @@ func (e *Engine) startupAnim() {
 	}
 	e.Cls(bgCol)
 
+	// The animation is laid out for a 192x192 screen, it's
+	// scaled down to fit smaller screens and centered
+	w, h := e.ScreenSize()
+	k := math.Min(1, float64(w)/192)
+	ox, oy := (float64(w)-192*k)/2, (float64(h)-192*k)/2
+
 	// Draw "made with"
 	txtf := 0
 	if frame > startText {
@@ -46,8 +52,8 @@ func (e *Engine) startupAnim() {
 	if frame > startText+fadeLen {
 		txtf = 7
 	}
-	tCol := TOp{Col: fadeTo[txtf][0], SH: 2, SW: 2}
-	e.Text(10, 50, "made with", tCol)
+	tCol := TOp{Col: fadeTo[txtf][0], SH: 2 * k, SW: 2 * k}
+	e.Text(10*k+ox, 50*k+oy, "made with", tCol)
 
 	// Draw golf logo
 	sprf := 0
@@ -66,15 +72,16 @@ func (e *Engine) startupAnim() {
 	if frame > endLogo+fadeLen {
 		sprf = 14
 	}
+	s *= k
 	width := 64.0 * s
 
 	// Change to the internal sprite sheet
-	e.RAM[activeSpriteBuff] = internalSpriteBase >> 8
-	e.RAM[activeSpriteBuff+1] = internalSpriteBase & 0b0000000011111111
+	e.RAM[ActiveSpriteBuff] = InternalSpriteBase >> 8
+	e.RAM[ActiveSpriteBuff+1] = InternalSpriteBase & 0b0000000011111111
 
-	e.SSpr(152, 0, 64, 24, float64(96-(width/2)), 64.0, SOp{TCol: Col1, SW: s, SH: s, PFrom: fadeFrom, PTo: fadeTo[sprf]})
+	e.SSpr(152, 0, 64, 24, float64(w)/2-width/2, 64*k+oy, SOp{TCol: Col1, SW: s, SH: s, PFrom: fadeFrom, PTo: fadeTo[sprf]})
 
 	// Change back to the main sprite sheet
-	e.RAM[activeSpriteBuff] = spriteBase >> 8
-	e.RAM[activeSpriteBuff+1] = spriteBase & 0b0000000011111111
+	e.RAM[ActiveSpriteBuff] = SpriteBase >> 8
+	e.RAM[ActiveSpriteBuff+1] = SpriteBase & 0b0000000011111111
 }
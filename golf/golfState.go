@@ -0,0 +1,89 @@
+package golf
+
+import (
+	"bytes"
+	"compress/flate"
+	"errors"
+	"fmt"
+	"io/ioutil"
+)
+
+// stateMagic and stateVersion start every save state
+const (
+	stateMagic   = "GLFS"
+	stateVersion = 2
+)
+
+// stateHeadLen is the length of the save state header
+// magic, version, TextL y, TextR y
+const stateHeadLen = len(stateMagic) + 1 + 2 + 2
+
+// SaveState returns a snapshot of the engine's RAM and text cursors.
+// The RAM is compressed so the snapshot is usually much smaller than the RAM.
+// State kept outside the RAM (the PushState stack, fonts added with AddFont,
+// the RNG, replays and GIF captures) is not part of the snapshot
+func (e *Engine) SaveState() []byte {
+	ret := &bytes.Buffer{}
+	ret.WriteString(stateMagic)
+	ret.WriteByte(stateVersion)
+	ret.Write(toBytes(textLy, 2, false))
+	ret.Write(toBytes(textRy, 2, false))
+
+	w, _ := flate.NewWriter(ret, flate.BestSpeed)
+	w.Write(e.RAM[:])
+	w.Close()
+
+	return ret.Bytes()
+}
+
+// LoadState restores a snapshot created by SaveState.
+// The current keyboard and mouse state are kept
+func (e *Engine) LoadState(state []byte) error {
+	if len(state) < stateHeadLen || string(state[:len(stateMagic)]) != stateMagic {
+		return errors.New("not a golf save state")
+	}
+	if state[len(stateMagic)] != stateVersion {
+		return fmt.Errorf("unsupported golf save state version %d", state[len(stateMagic)])
+	}
+
+	ram, err := ioutil.ReadAll(flate.NewReader(bytes.NewReader(state[stateHeadLen:])))
+	if err != nil {
+		return err
+	}
+	if len(ram) != len(e.RAM) {
+		return errors.New("corrupted golf save state")
+	}
+
+	input := [inputSize]byte{}
+	copy(input[:], e.RAM[inputBase:inputBase+inputSize])
+	style := ram[MouseBase] &^ mouseBtnMask
+
+	copy(e.RAM[:], ram)
+	copy(e.RAM[inputBase:inputBase+inputSize], input[:])
+	e.RAM[MouseBase] = e.RAM[MouseBase]&mouseBtnMask | style
+
+	head := state[len(stateMagic)+1:]
+	textLy = toInt(head[0:2], false)
+	textRy = toInt(head[2:4], false)
+	return nil
+}
+
+// stateSlotName is the cart data name used for save state slots
+func stateSlotName(slot int) string {
+	return fmt.Sprintf("golf_state_%d", slot)
+}
+
+// SaveSlot saves a snapshot of the engine into the numbered slot
+// using the backend's storage (local storage in the browser)
+func (e *Engine) SaveSlot(slot int) error {
+	return e.backend.Store(stateSlotName(slot), e.SaveState())
+}
+
+// LoadSlot restores the snapshot saved in the numbered slot
+func (e *Engine) LoadSlot(slot int) error {
+	state, ok := e.backend.Fetch(stateSlotName(slot))
+	if !ok {
+		return fmt.Errorf("save state slot %d is empty", slot)
+	}
+	return e.LoadState(state)
+}
@@ -0,0 +1,35 @@
+package golf
+
+// SetDrawTarget makes all draw functions draw to the pixel buffer at addr
+// rather than the screen. w and h are the size of the buffer in pixels,
+// w must be a multiple of 8. The clipping rect is reset to cover the buffer.
+// e.g. SetDrawTarget(SpriteBase, 256, 128) draws to the user sprite sheet
+func (e *Engine) SetDrawTarget(addr, w, h int) {
+	w = w / 8 * 8
+	if w <= 0 || h <= 0 || !e.inRAM(addr, w*h/8*3) {
+		return
+	}
+	e.Poke16(DrawTarget, addr)
+	e.Poke16(DrawTargetW, w)
+	e.Poke16(DrawTargetH, h)
+	e.RClip()
+}
+
+// ResetDrawTarget makes all draw functions draw to the screen again
+// and resets the clipping rect
+func (e *Engine) ResetDrawTarget() {
+	w, h := e.ScreenSize()
+	e.SetDrawTarget(ScreenBuffBase, w, h)
+}
+
+// drawTarget gets the address, width and height of the current draw target.
+// If the draw target has not been set the screen is used
+func (e *Engine) drawTarget() (addr, w, h int) {
+	w = e.Peek16(DrawTargetW)
+	h = e.Peek16(DrawTargetH)
+	if w == 0 || h == 0 {
+		w, h = e.ScreenSize()
+		return ScreenBuffBase, w, h
+	}
+	return e.Peek16(DrawTarget), w, h
+}
@@ -1,7 +1,10 @@
 package golf
 
 import (
+	"math"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 // TOp additional options for drawing text
@@ -9,39 +12,42 @@ type TOp struct {
 	Col    Col
 	Fixed  bool
 	SW, SH float64
+	Font   int
+	Lower  bool
 }
 
-// the TextLine for TextL and TextR
-var textLline, textRline = 0, 0
+// the y offset of the next line of TextL and TextR
+var textLy, textRy = 0, 0
 
 // TextL prints text at the top left of the screen
 // the cursor moves to a new line each time TextL is called
 func (e *Engine) TextL(text string, opts ...TOp) {
+	opt := TOp{}
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	opt.Fixed = true
 	splitText := strings.Split(text, "\n")
 	for _, line := range splitText {
-		if len(opts) > 0 {
-			opts[0].Fixed = true
-			e.Text(1, float64(1+6*textLline), line, opts[0])
-		} else {
-			e.Text(1, float64(1+6*textLline), line, TOp{Fixed: true})
-		}
-		textLline++
+		e.Text(1, float64(1+textLy), line, opt)
+		textLy += int(e.TextHeight(line, opt))
 	}
 }
 
 // TextR prints text at the top right of the screen
 // the cursor moves to a new line each time TextR is called
 func (e *Engine) TextR(text string, opts ...TOp) {
+	opt := TOp{}
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	opt.Fixed = true
+	w, _ := e.ScreenSize()
 	splitText := strings.Split(text, "\n")
 	for _, line := range splitText {
-		x := ScreenWidth - 1 - len(line)*6
-		if len(opts) > 0 {
-			opts[0].Fixed = true
-			e.Text(float64(x), float64(1+6*textRline), line, opts[0])
-		} else {
-			e.Text(float64(x), float64(1+6*textRline), line, TOp{Fixed: true})
-		}
-		textRline++
+		x := float64(w) - 1 - e.TextWidth(line, opt)
+		e.Text(x, float64(1+textRy), line, opt)
+		textRy += int(e.TextHeight(line, opt))
 	}
 }
 
@@ -50,79 +56,386 @@ const textRef = "abcdefghijklmnopqrstuvwxyz0123456789!@#$%^&*()-_+={}[]\\:;\"<>,
 const btnRef = "(<)(>)(^)(v)(x)(o)(l)(r)(+)(-)"
 const specialRef = ":):(x(:|=[|^|v<-->$$@@<|<3<4+1-1~~()[]:;**"
 
-// Text prints text at the x, y coords on the screen
-func (e *Engine) Text(x, y float64, text string, opts ...TOp) {
-	text = strings.ToLower(text)
-	px, py := x, y
-	opt := TOp{}
-	sopt := SOp{TCol: Col1, SH: 1, SW: 1}
-	if len(opts) > 0 {
-		opt = opts[0]
-	}
-	if opt.Col != 0 {
-		sopt.PFrom = []Col{Col0}
-		sopt.PTo = []Col{opt.Col}
+// the extended font reference, these glyphs are on the InternalFontBase sprite sheet.
+// The last glyph is drawn for any character the built in font doesn't have
+const textRefExt = "abcdefghijklmnopqrstuvwxyzÀÁÂÄÇÈÉÊËÍÏÑÒÓÔÖÙÚÜàáâäçèéêëíïñòóôöùúüß¿¡'`~°\uFFFD"
+
+// extGlyphs is the number of the first extended glyph in the built in font
+const extGlyphs = 96
+
+// textExtIndex is the glyph number of each character in textRefExt
+var textExtIndex = func() map[rune]int {
+	ret := map[rune]int{}
+	i := extGlyphs
+	for _, r := range textRefExt {
+		ret[r] = i
+		i++
 	}
+	return ret
+}()
+
+// textStyle is how a glyph is drawn, it's changed by the markup tags in the text
+type textStyle struct {
+	col           Col
+	sw, sh        float64
+	wobble, shake bool
+	font          int
+	lower         bool
+}
+
+// style gets the text style set by the text options
+func (opt TOp) style() textStyle {
+	ret := textStyle{col: opt.Col, sw: 1, sh: 1, font: opt.Font, lower: opt.Lower}
 	if opt.SH != 0 {
-		sopt.SH = opt.SH
+		ret.sh = opt.SH
 	}
 	if opt.SW != 0 {
-		sopt.SW = opt.SW
+		ret.sw = opt.SW
 	}
-	sopt.Fixed = opt.Fixed
-	width := 6 * sopt.SW
-	height := 6 * sopt.SH
+	return ret
+}
 
-	e.setActiveSpriteBuff(internalSpriteBase)
+// glyph is a single laid out character. dex is the index of the character
+// in the font and x, y is the offset from the text position. w is how far the
+// cursor moves after the glyph and h is the height of the line, both are scaled
+type glyph struct {
+	dex, font int
+	x, y      float64
+	w, h      float64
+	line      int
+	space     bool
+	style     textStyle
+}
+
+// textLayout is text that has been split into glyphs and lines.
+// widths is the width of each line without its trailing spaces, it's used to align the lines
+type textLayout struct {
+	glyphs []glyph
+	widths []float64
+	w, h   float64
+}
+
+// textTag parses the markup tag at the start of text. n is the length
+// of the tag, if text does not start with a valid tag n is 0.
+// {c0}-{c7} change the color to Col0-Col7, {s1}-{s9} change the scale, {f0}-{f9}
+// change the font, {w} wobbles the text, {k} shakes it and {/} goes back to the
+// style passed to Text
+func textTag(text string, style, reset textStyle) (textStyle, int) {
+	end := strings.IndexByte(text, '}')
+	if len(text) < 3 || text[0] != '{' || end < 0 {
+		return style, 0
+	}
+	switch tag := strings.ToLower(text[1:end]); {
+	case tag == "/":
+		return reset, end + 1
+	case tag == "w":
+		style.wobble = true
+	case tag == "k":
+		style.shake = true
+	case len(tag) == 2 && tag[0] == 'c' && tag[1] >= '0' && tag[1] <= '7':
+		style.col = Col(tag[1]-'0') | 0b10000000
+	case len(tag) == 2 && tag[0] == 's' && tag[1] >= '1' && tag[1] <= '9':
+		style.sw = float64(tag[1] - '0')
+		style.sh = style.sw
+	case len(tag) == 2 && tag[0] == 'f' && tag[1] >= '0' && tag[1] <= '9':
+		style.font = int(tag[1] - '0')
+	default:
+		return style, 0
+	}
+	return style, end + 1
+}
+
+// layoutText splits text into glyphs, working out the escapes, emojis and markup tags.
+// Glyphs on the same line sit on the bottom of the line which is as tall as its biggest glyph.
+// If wrap is more than 0 lines are broken between words so they are no wider than wrap,
+// words that are wider than wrap are broken between characters
+func (e *Engine) layoutText(text string, style textStyle, wrap float64) textLayout {
+	reset := style
+	l := textLayout{}
+	px, py := 0.0, 0.0
+	start := 0
+
+	// endLine ends the line that is px wide and moves its glyphs
+	// down so they sit on the bottom of the line. Trailing spaces
+	// are left out of the width used to align the line
+	endLine := func() {
+		lineH := 0.0
+		for _, g := range l.glyphs[start:] {
+			lineH = math.Max(lineH, g.h)
+		}
+		if lineH == 0 {
+			_, h := e.glyphSize(style.font, -1)
+			lineH = h * style.sh
+		}
+		width := 0.0
+		for i := start; i < len(l.glyphs); i++ {
+			l.glyphs[i].y = py + lineH - l.glyphs[i].h
+			l.glyphs[i].line = len(l.widths)
+			if !l.glyphs[i].space {
+				width = l.glyphs[i].x + l.glyphs[i].w
+			}
+		}
+		l.widths = append(l.widths, width)
+		l.w = math.Max(l.w, px)
+		px, py = 0, py+lineH
+		start = len(l.glyphs)
+	}
+
+	// wrapLine breaks the line at the last space on it, or at the end
+	// of the line if there are no spaces, and starts a new line
+	wrapLine := func() {
+		brk := len(l.glyphs)
+		for i := len(l.glyphs) - 1; i >= start; i-- {
+			if l.glyphs[i].space {
+				brk = i
+				break
+			}
+		}
+		end := brk
+		for end > start && l.glyphs[end-1].space {
+			end--
+		}
+
+		next := []glyph{}
+		if brk < len(l.glyphs) {
+			next = append(next, l.glyphs[brk+1:]...)
+		}
+		lineEnd := px
+		if end < len(l.glyphs) {
+			px = l.glyphs[end].x
+		}
+		l.glyphs = l.glyphs[:end]
+		endLine()
+
+		off := lineEnd
+		if len(next) > 0 {
+			off = next[0].x
+		}
+		for _, g := range next {
+			g.x -= off
+			l.glyphs = append(l.glyphs, g)
+		}
+		px = lineEnd - off
+	}
+
+	add := func(dex, font int, space bool) {
+		w, h := e.glyphSize(font, dex)
+		w, h = w*style.sw, h*style.sh
+		if wrap > 0 && !space && px+w > wrap && len(l.glyphs) > start {
+			wrapLine()
+		}
+		l.glyphs = append(l.glyphs, glyph{dex: dex, font: font, x: px, w: w, h: h, space: space, style: style})
+		px += w
+	}
 
 	for i := 0; i < len(text); i++ {
 		if text[i] == '\n' {
-			px = x
-			py += height //6
+			endLine()
 			continue
 		}
 		if text[i] == ' ' {
-			px += width //6
+			dex, font := e.fontChar(style.font, ' ', style.lower)
+			add(dex, font, true)
 			continue
 		}
 		if text[i] == '^' {
-			i++
-			dex := strings.Index(textRef, string(text[i]))
-			e.drawChar(px, py, dex, sopt)
-			px += width //6
+			if i+1 < len(text) {
+				r, n := utf8.DecodeRuneInString(text[i+1:])
+				dex, font := e.fontChar(style.font, r, style.lower)
+				add(dex, font, false)
+				i += n
+			}
 			continue
 		}
+		if next, n := textTag(text[i:], style, reset); n > 0 {
+			style = next
+			i += n - 1
+			continue
+		}
+		// emojis are always drawn using the built in font
 		bdex := -1
 		if i+2 < len(text) {
-			bdex = strings.Index(btnRef, string(text[i:i+3]))
+			bdex = strings.Index(btnRef, strings.ToLower(text[i:i+3]))
 		}
 		if bdex%3 == 0 {
-			bdex = bdex/3 + 86
-			e.drawChar(px, py, bdex, sopt)
-			px += width //6
+			add(bdex/3+86, 0, false)
 			i += 2
 			continue
 		}
 		sdex := -1
 		if i+1 < len(text) {
-			sdex = strings.Index(specialRef, string(text[i:i+2]))
+			sdex = strings.Index(specialRef, strings.ToLower(text[i:i+2]))
 		}
 		if sdex%2 == 0 {
-			sdex = sdex/2 + 65
-			e.drawChar(px, py, sdex, sopt)
-			px += width //6
+			add(sdex/2+65, 0, false)
 			i++
 			continue
 		}
-		dex := -1
-		if i < len(text) {
-			dex = strings.Index(textRef, string(text[i]))
+		r, n := utf8.DecodeRuneInString(text[i:])
+		dex, font := e.fontChar(style.font, r, style.lower)
+		add(dex, font, false)
+		i += n - 1
+	}
+	endLine()
+	l.h = py
+
+	return l
+}
+
+// fontChar finds the glyph for the character r in font n. If font n
+// doesn't have the character the built in font is used, see builtinChar.
+// Spaces and control characters are blank
+func (e *Engine) fontChar(n int, r rune, lower bool) (dex, font int) {
+	if f := e.font(n); f != nil {
+		for _, r := range []rune{r, unicode.ToLower(r), unicode.ToUpper(r)} {
+			if i, ok := f.index[r]; ok {
+				return i, n
+			}
+		}
+	}
+	if r == ' ' || unicode.IsControl(r) {
+		return -1, n
+	}
+	return builtinChar(r, lower), 0
+}
+
+// builtinChar finds the glyph for the character r in the built in font. Letters are
+// drawn in upper case unless lower is set and characters the font doesn't have are
+// drawn using the replacement glyph
+func builtinChar(r rune, lower bool) int {
+	if !lower {
+		r = unicode.ToUpper(r)
+	}
+	if r < 'a' || r > 'z' {
+		if dex := strings.IndexRune(textRef, unicode.ToLower(r)); r < utf8.RuneSelf && dex >= 0 {
+			return dex
+		}
+	}
+	if dex, ok := textExtIndex[r]; ok {
+		return dex
+	}
+	return textExtIndex[utf8.RuneError]
+}
+
+// Text prints text at the x, y coords on the screen.
+// The text can include markup tags to change how it's drawn, see textTag
+func (e *Engine) Text(x, y float64, text string, opts ...TOp) {
+	opt := TOp{}
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	l := e.layoutText(text, opt.style(), 0)
+	e.drawGlyphs(x, y, l.glyphs, opt.Fixed)
+}
+
+// TextWidth gets the width of the text in pixels. Escapes, emojis, markup
+// tags and the TOp scale are all taken into account. If the text has
+// several lines the width of the widest line is returned
+func (e *Engine) TextWidth(text string, opts ...TOp) float64 {
+	opt := TOp{}
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	return e.layoutText(text, opt.style(), 0).w
+}
+
+// TextHeight gets the height of the text in pixels, including all its lines
+func (e *Engine) TextHeight(text string, opts ...TOp) float64 {
+	opt := TOp{}
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	return e.layoutText(text, opt.style(), 0).h
+}
+
+// Align is how the lines of a TextBox line up
+type Align int
+
+// Text alignments
+const (
+	AlignLeft = Align(iota)
+	AlignCenter
+	AlignRight
+)
+
+// TextBox prints text inside the box with the upper left corner at x, y and
+// a width of w and height of h. Words are wrapped onto a new line when they
+// don't fit in the box and each line is lined up using align. Lines that would
+// go past the bottom of the box are not drawn
+func (e *Engine) TextBox(x, y, w, h float64, text string, align Align, opts ...TOp) {
+	opt := TOp{}
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	l := e.layoutText(text, opt.style(), w)
+
+	// find the first line that doesn't fit in the box
+	lines := len(l.widths)
+	for _, g := range l.glyphs {
+		if g.line < lines && g.y+g.h > h {
+			lines = g.line
+		}
+	}
+
+	glyphs := []glyph{}
+	for _, g := range l.glyphs {
+		if g.line >= lines {
+			continue
+		}
+		switch align {
+		case AlignCenter:
+			g.x += math.Floor((w - l.widths[g.line]) / 2)
+		case AlignRight:
+			g.x += w - l.widths[g.line]
+		}
+		glyphs = append(glyphs, g)
+	}
+	e.drawGlyphs(x, y, glyphs, opt.Fixed)
+}
+
+// drawGlyphs draws the laid out glyphs with their offsets from x, y
+func (e *Engine) drawGlyphs(x, y float64, glyphs []glyph, fixed bool) {
+	frame := e.Frames()
+	for i, g := range glyphs {
+		if g.dex < 0 || g.space {
+			continue
+		}
+		f := e.font(g.font)
+		sopt := SOp{TCol: Col1, SW: g.style.sw, SH: g.style.sh, Fixed: fixed}
+		ink := Col0
+		if f != nil {
+			sopt.TCol, ink = f.TCol, f.Ink
+		}
+		if g.style.col != 0 {
+			sopt.PFrom = []Col{ink}
+			sopt.PTo = []Col{g.style.col}
+		}
+		dx, dy := 0.0, 0.0
+		if g.style.wobble {
+			dy += math.Round(math.Sin(float64(frame)/6+float64(i)/2) * g.style.sh)
+		}
+		if g.style.shake {
+			// a cheap hash so each glyph shakes differently and the rng isn't used up
+			h := uint32(frame/2*97+i*31) * 2654435761
+			dx += float64(int(h>>8%3)-1) * g.style.sw
+			dy += float64(int(h>>16%3)-1) * g.style.sh
+		}
+		if f == nil && g.dex >= extGlyphs {
+			e.setActiveSpriteBuff(InternalFontBase)
+			e.drawChar(x+g.x+dx, y+g.y+dy, g.dex-extGlyphs, sopt)
+			continue
+		}
+		if f == nil {
+			e.setActiveSpriteBuff(InternalSpriteBase)
+			e.drawChar(x+g.x+dx, y+g.y+dy, g.dex, sopt)
+			continue
 		}
-		e.drawChar(px, py, dex, sopt)
-		px += width //6
+		e.setActiveSpriteBuff(SpriteBase)
+		sx, sy := f.cell(g.dex)
+		e.SSpr(sx, sy, f.W, f.H, x+g.x+dx, y+g.y+dy, sopt)
 	}
 
-	e.setActiveSpriteBuff(spriteBase)
+	e.setActiveSpriteBuff(SpriteBase)
 }
 
 func (e *Engine) drawChar(x, y float64, i int, opt SOp) {
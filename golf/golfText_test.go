@@ -0,0 +1,155 @@
+package golf
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"unicode/utf8"
+)
+
+// drawScreen clears the screen to Col7 so the built in font shows up, runs draw
+// and returns a copy of the screen buffer
+func drawScreen(e *Engine, draw func()) []byte {
+	e.Cls(Col7)
+	draw()
+	return append([]byte{}, e.RAM[:ScreenPalSet]...)
+}
+
+func TestTextMeasure(t *testing.T) {
+	e := newTestEngine(NewHeadless())
+	cases := []struct {
+		text string
+		opt  TOp
+		w, h float64
+	}{
+		{"abc", TOp{}, 18, 6},
+		{"(x) go", TOp{}, 24, 6},
+		{":)", TOp{SW: 2, SH: 3}, 12, 18},
+		{"ab\nabcd", TOp{}, 24, 12},
+		{"a{s2}b{/}c", TOp{}, 24, 12},
+		{"^**", TOp{}, 12, 6},
+		{"", TOp{}, 0, 6},
+	}
+	for _, c := range cases {
+		w, h := e.TextWidth(c.text, c.opt), e.TextHeight(c.text, c.opt)
+		if w != c.w || h != c.h {
+			t.Errorf("%q is %v x %v, want %v x %v", c.text, w, h, c.w, c.h)
+		}
+	}
+}
+
+func TestTextLineWidths(t *testing.T) {
+	e := newTestEngine(NewHeadless())
+	cases := []struct {
+		text   string
+		wrap   float64
+		widths []float64
+	}{
+		{"ab", 0, []float64{12}},
+		{"ab  ", 0, []float64{12}},
+		{"ab \n cd  \n", 0, []float64{12, 18, 0}},
+		{"   ", 0, []float64{0}},
+		{"hello world  ", 40, []float64{30, 30}},
+		{"hi there you ", 40, []float64{12, 30, 18}},
+	}
+	for _, c := range cases {
+		l := e.layoutText(c.text, TOp{}.style(), c.wrap)
+		if fmt.Sprint(l.widths) != fmt.Sprint(c.widths) {
+			t.Errorf("%q wrapped at %v has line widths %v, want %v", c.text, c.wrap, l.widths, c.widths)
+		}
+	}
+}
+
+func TestTextBoxTrailingSpaces(t *testing.T) {
+	e := newTestEngine(NewHeadless())
+	cases := [][2]string{
+		{"ab  ", "ab"},
+		{"ab \ncd", "ab\ncd"},
+		{"ab\ncd   ", "ab\ncd"},
+		{"hello world  ", "hello world"},
+	}
+	for _, align := range []Align{AlignLeft, AlignCenter, AlignRight} {
+		for _, c := range cases {
+			got := drawScreen(e, func() { e.TextBox(10, 10, 40, 40, c[0], align) })
+			want := drawScreen(e, func() { e.TextBox(10, 10, 40, 40, c[1], align) })
+			if !bytes.Equal(got, want) {
+				t.Errorf("align %d: %q isn't drawn the same as %q", align, c[0], c[1])
+			}
+		}
+	}
+}
+
+func TestTextGlyphs(t *testing.T) {
+	e := newTestEngine(NewHeadless())
+	replacement := extGlyphs + 71
+	cases := []struct {
+		lower bool
+		want  []int
+	}{
+		// A, B, É, Ñ, Ü and the replacement glyph for €
+		{false, []int{0, 1, extGlyphs + 32, extGlyphs + 37, extGlyphs + 44, replacement}},
+		// A, b, É, ñ, ü and the replacement glyph for €
+		{true, []int{0, extGlyphs + 1, extGlyphs + 32, extGlyphs + 56, extGlyphs + 63, replacement}},
+	}
+	for _, c := range cases {
+		l := e.layoutText("AbÉñü€", TOp{Lower: c.lower}.style(), 0)
+		got := []int{}
+		for _, g := range l.glyphs {
+			got = append(got, g.dex)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(c.want) {
+			t.Errorf("lower %v: got glyphs %v, want %v", c.lower, got, c.want)
+		}
+	}
+
+	if dex := builtinChar('�', false); dex != replacement {
+		t.Errorf("\\uFFFD is glyph %d, want the replacement glyph %d", dex, replacement)
+	}
+	for _, r := range []rune{'€', '漢', 'ÿ', utf8.RuneError} {
+		if dex := builtinChar(r, true); dex != replacement {
+			t.Errorf("%q is glyph %d, want the replacement glyph %d", r, dex, replacement)
+		}
+	}
+}
+
+func TestTextDraw(t *testing.T) {
+	e := newTestEngine(NewHeadless())
+	blank := drawScreen(e, func() {})
+	text := func(s string, opt TOp) []byte {
+		return drawScreen(e, func() { e.Text(10, 10, s, opt) })
+	}
+
+	for _, lower := range []bool{false, true} {
+		opt := TOp{Lower: lower}
+		if bytes.Equal(text("AbÉñü€", opt), blank) {
+			t.Errorf("lower %v: nothing was drawn", lower)
+		}
+
+		// unmapped characters and bad utf-8 are drawn with the replacement glyph
+		want := text("�", opt)
+		if bytes.Equal(want, blank) {
+			t.Errorf("lower %v: the replacement glyph is blank", lower)
+		}
+		for _, s := range []string{"€", "漢", "\xff"} {
+			if !bytes.Equal(text(s, opt), want) {
+				t.Errorf("lower %v: %q isn't drawn with the replacement glyph", lower, s)
+			}
+		}
+
+		// control characters are blank
+		if !bytes.Equal(text("a\tb", opt), text("a b", opt)) {
+			t.Errorf("lower %v: a tab isn't drawn as a blank", lower)
+		}
+		if !bytes.Equal(text("\x01^\x02", opt), blank) {
+			t.Errorf("lower %v: control characters were drawn", lower)
+		}
+	}
+
+	// letters are upper case unless Lower is set
+	if !bytes.Equal(text("AbÉñü", TOp{}), text("ABÉÑÜ", TOp{})) {
+		t.Error("lower case letters aren't drawn upper case")
+	}
+	if bytes.Equal(text("AbÉñü", TOp{Lower: true}), text("ABÉÑÜ", TOp{Lower: true})) {
+		t.Error("Lower didn't draw the lower case glyphs")
+	}
+}
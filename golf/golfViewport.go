@@ -0,0 +1,51 @@
+package golf
+
+import "math"
+
+// Viewport makes all draw functions draw inside the screen rect x, y, w, h.
+// The camera is moved to camX, camY and each pixel is drawn zoom times bigger.
+// This makes it easy to draw split screen games or a minimap
+func (e *Engine) Viewport(x, y, w, h, camX, camY, zoom int) {
+	if zoom < 1 {
+		zoom = 1
+	}
+	if zoom > 255 {
+		zoom = 255
+	}
+	e.RAM[ViewX] = byte(x)
+	e.RAM[ViewY] = byte(y)
+	e.RAM[ViewZoom] = byte(zoom)
+	e.Clip(x, y, w, h)
+	e.Camera(camX, camY)
+}
+
+// RViewport resets the viewport so draw functions draw to the whole screen again.
+// The clip and camera are also reset
+func (e *Engine) RViewport() {
+	e.RAM[ViewX] = 0
+	e.RAM[ViewY] = 0
+	e.RAM[ViewZoom] = 0
+	e.RClip()
+	e.Camera(0, 0)
+}
+
+// view gets the screen offset and zoom of the current viewport
+func (e *Engine) view() (x, y, zoom float64) {
+	zoom = float64(e.RAM[ViewZoom])
+	if zoom < 1 {
+		zoom = 1
+	}
+	return float64(e.RAM[ViewX]), float64(e.RAM[ViewY]), zoom
+}
+
+// viewClip gets the clipping rect in viewport coordinates. Draw functions
+// that loop over the pixels in the clipping rect should use this
+func (e *Engine) viewClip() (x, y, w, h int) {
+	x, y, w, h = e.clipRect()
+	vx, vy, z := e.view()
+	x1 := math.Floor((float64(x) - vx) / z)
+	y1 := math.Floor((float64(y) - vy) / z)
+	x2 := math.Ceil((float64(x+w) - vx) / z)
+	y2 := math.Ceil((float64(y+h) - vy) / z)
+	return int(x1), int(y1), int(x2 - x1), int(y2 - y1)
+}
@@ -1,64 +1,151 @@
 package golf
 
-// Addresses
+// The GoLF memory map. These are the addresses of all the
+// engine's data in RAM. Use them with Peek, Poke, Memcpy and Memset
+
+// ScreenBuffBase is the start of the screen buffer
 // ScreenBuff: 0x0000 - 0x3601
-//  Col Buff: 0x0000 - 0x23FF
-const screenBuffBase = 0x0000
+const ScreenBuffBase = 0x0000
 
-//  Pal Set: 0x3600
-const screenPalSet = 0x3600
+// ScreenPalSet is the screen pallets, the top 4 bits are pallet A the bottom 4 are pallet B
+// Pal Set: 0x3600
+const ScreenPalSet = 0x3600
 
+// StartAnim is the number of frames to play the startup animation
 // StartAnimLen: 0x3601
-const startAnim = 0x3601
+const StartAnim = 0x3601
 
+// CameraX is the signed 16 bit camera x coordinate
 // CameraX: 0x3602-0x3603
-const cameraX = 0x3602
+const CameraX = 0x3602
 
+// CameraY is the signed 16 bit camera y coordinate
 // CameraY: 0x3604-0x3605
-const cameraY = 0x3604
+const CameraY = 0x3604
 
+// FrameCount is the 24 bit count of frames since the engine started
 // Frames: 0x3606-0x3608
-const frames = 0x3606
+const FrameCount = 0x3606
 
+// ClipX is the x coordinate of the clipping rect
 // ClipX: 0x3609
-const clipX = 0x3609
+const ClipX = 0x3609
 
+// ClipY is the y coordinate of the clipping rect
 // ClipY: 0x360A
-const clipY = 0x360A
+const ClipY = 0x360A
 
+// ClipW is the width of the clipping rect
 // ClipW: 0x360B
-const clipW = 0x360B
+const ClipW = 0x360B
 
+// ClipH is the height of the clipping rect
 // ClipH: 0x360C
-const clipH = 0x360C
+const ClipH = 0x360C
 
-// Mouse:
-//  X: 0x360D
-const mouseX = 0x360D
+// MouseX is the mouse x coordinate
+// Mouse X: 0x360D
+const MouseX = 0x360D
 
-//	Y: 0x360E
-const mouseY = 0x360E
+// MouseY is the mouse y coordinate
+// Mouse Y: 0x360E
+const MouseY = 0x360E
 
-//	Left Click: 0x360F
-//	Middle Click: 0x360F
-//	Right Click: 0x360F
-//	Mouse Style: 0x360F
-const mouseBase = 0x360F
+// MouseBase is the mouse button state and mouse style
+// Left Click, Middle Click, Right Click, Mouse Style: 0x360F
+const MouseBase = 0x360F
 
+// KeyBase is the start of the keyboard state, 2 bits per key
 // Keyboard: 0x3610-0x3646
-const keyBase = 0x3610
+const KeyBase = 0x3610
 
+// InternalSpriteBase is the start of the font, emoji, logo and mouse sprites
 // InternalSpriteSheet: 0x3647-0x3F47 [0x0900]
-const internalSpriteBase = 0x3647
+const InternalSpriteBase = 0x3647
 
+// SpriteBase is the start of the user sprite sheet
 // SpriteSheet: 0x3F48-0x6F48 [0x3000]
-const spriteBase = 0x3F48
+const SpriteBase = 0x3F48
 
+// ActiveSpriteBuff is the 16 bit address of the sprite sheet used by the sprite functions
 // ActiveSpriteBuff: 0x6F49-0x6F4A
-const activeSpriteBuff = 0x6F49
+const ActiveSpriteBuff = 0x6F49
 
+// MapBase is the start of the map data, the map grows down from here
 // MapData (128x128 / 512 8x8): 0x6F4B - 0xB74B [0x4800]
-const mapBase = 0xB74B //Start from the high memory so the map grows down
+const MapBase = 0xB74B //Start from the high memory so the map grows down
 
+// SpriteFlags is the start of the sprite flags, one byte per sprite
 // SpriteFlags (512 8x8): 0xB74C - B94C
-const spriteFlags = 0xB74E
+const SpriteFlags = 0xB74E
+
+// DrawTarget is the 16 bit address of the pixel buffer all draw functions draw to
+// DrawTarget: 0xB94E-0xB94F
+const DrawTarget = 0xB94E
+
+// DrawTargetW is the 16 bit width of the draw target in pixels
+// DrawTargetW: 0xB950-0xB951
+const DrawTargetW = 0xB950
+
+// DrawTargetH is the 16 bit height of the draw target in pixels
+// DrawTargetH: 0xB952-0xB953
+const DrawTargetH = 0xB952
+
+// ClipHi is the 9th bit of ClipW (bit 0) and ClipH (bit 1)
+// ClipHi: 0xB954
+const ClipHi = 0xB954
+
+// FillPattern is the 16 bit 4x4 fill pattern used by the fill functions
+// FillPattern: 0xB955-0xB956
+const FillPattern = 0xB955
+
+// FillCol is the color of the set bits in the fill pattern, 0 is transparent
+// FillCol: 0xB957
+const FillCol = 0xB957
+
+// DrawPal is the draw pallet, color n is drawn as the color at DrawPal+n (0 is not mapped)
+// DrawPal: 0xB958-0xB95F
+const DrawPal = 0xB958
+
+// DrawPalT is the transparent color mask, if bit n is set color n is transparent in sprites
+// DrawPalT: 0xB960
+const DrawPalT = 0xB960
+
+// DispPal is the display pallet, if the top bit of DispPal+n is set screen color n is
+// shown as the pallet (bits 2-5) and shade (bits 0-1) it holds rather than the PalA/PalB color
+// DispPal: 0xB961-0xB968
+const DispPal = 0xB961
+
+// DispFade is the screen fade, it's a signed byte from -127 (black) to 127 (white)
+// DispFade: 0xB969
+const DispFade = 0xB969
+
+// DispCycle is the 4 pallet cycles, each cycle is 2 bytes. The first byte is
+// the enable bit, the first color (bits 3-5) and the last color (bits 0-2).
+// The second is the signed number of frames per step
+// DispCycle: 0xB96A-0xB971
+const DispCycle = 0xB96A
+
+// ScanPalOn is 1 bit for each scanline, if it's set the scanline uses ScanPal
+// ScanPalOn: 0xB972-0xB989
+const ScanPalOn = 0xB972
+
+// ScanPal is the pallets for each scanline, they use the same format as ScreenPalSet
+// ScanPal: 0xB98A-0xBA49
+const ScanPal = 0xB98A
+
+// ViewX is the x position of the viewport on the screen
+// ViewX: 0xBA4A
+const ViewX = 0xBA4A
+
+// ViewY is the y position of the viewport on the screen
+// ViewY: 0xBA4B
+const ViewY = 0xBA4B
+
+// ViewZoom is the zoom of the viewport, 0 and 1 both mean no zoom
+// ViewZoom: 0xBA4C
+const ViewZoom = 0xBA4C
+
+// InternalFontBase is the start of the lower case, accented and replacement font glyphs
+// InternalFontSheet: 0xBA4D-0xC10C [0x06C0]
+const InternalFontBase = 0xBA4D
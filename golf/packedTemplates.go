@@ -1,3 +1,3 @@
 package golf
 //generated code do not edit
-var drawTemplate=[0x71f]byte{0x76, 0x61, 0x72, 0x20, 0x63, 0x61, 0x6e, 0x76, 0x61, 0x73, 0x3d, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x49, 0x64, 0x28, 0x22, 0x67, 0x6f, 0x6c, 0x66, 0x63, 0x61, 0x6e, 0x76, 0x61, 0x73, 0x22, 0x29, 0x2c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x3d, 0x63, 0x61, 0x6e, 0x76, 0x61, 0x73, 0x2e, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x28, 0x22, 0x32, 0x64, 0x22, 0x29, 0x2c, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x64, 0x61, 0x74, 0x61, 0x3d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x28, 0x37, 0x36, 0x38, 0x2c, 0x37, 0x36, 0x38, 0x29, 0x2c, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x43, 0x6f, 0x6c, 0x3d, 0x39, 0x32, 0x31, 0x36, 0x2c, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x50, 0x61, 0x6c, 0x3d, 0x34, 0x36, 0x30, 0x38, 0x2c, 0x73, 0x65, 0x74, 0x50, 0x61, 0x6c, 0x3d, 0x31, 0x2c, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x42, 0x75, 0x66, 0x66, 0x3d, 0x6e, 0x65, 0x77, 0x20, 0x55, 0x69, 0x6e, 0x74, 0x38, 0x41, 0x72, 0x72, 0x61, 0x79, 0x28, 0x73, 0x65, 0x74, 0x50, 0x61, 0x6c, 0x2b, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x43, 0x6f, 0x6c, 0x2b, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x50, 0x61, 0x6c, 0x29, 0x3b, 0x63, 0x61, 0x6e, 0x76, 0x61, 0x73, 0x2e, 0x61, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x28, 0x22, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x6d, 0x65, 0x6e, 0x75, 0x22, 0x2c, 0x63, 0x3d, 0x3e, 0x63, 0x2e, 0x70, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x28, 0x29, 0x29, 0x3b, 0x76, 0x61, 0x72, 0x20, 0x66, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x3d, 0x5b, 0x30, 0x2c, 0x31, 0x2c, 0x32, 0x2c, 0x33, 0x2c, 0x34, 0x2c, 0x35, 0x2c, 0x36, 0x2c, 0x37, 0x5d, 0x3b, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x64, 0x72, 0x61, 0x77, 0x48, 0x69, 0x52, 0x65, 0x73, 0x50, 0x69, 0x78, 0x65, 0x6c, 0x28, 0x63, 0x2c, 0x64, 0x2c, 0x65, 0x29, 0x7b, 0x73, 0x3d, 0x34, 0x2a, 0x63, 0x2b, 0x34, 0x2a, 0x28, 0x34, 0x2a, 0x28, 0x31, 0x39, 0x32, 0x2a, 0x64, 0x29, 0x29, 0x3b, 0x66, 0x6f, 0x72, 0x28, 0x6c, 0x65, 0x74, 0x20, 0x66, 0x3d, 0x73, 0x3b, 0x66, 0x3c, 0x73, 0x2b, 0x34, 0x3b, 0x66, 0x2b, 0x2b, 0x29, 0x66, 0x6f, 0x72, 0x28, 0x6c, 0x65, 0x74, 0x20, 0x63, 0x3d, 0x30, 0x3b, 0x34, 0x3e, 0x63, 0x3b, 0x63, 0x2b, 0x2b, 0x29, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x5b, 0x34, 0x2a, 0x66, 0x2b, 0x34, 0x2a, 0x28, 0x34, 0x2a, 0x28, 0x31, 0x39, 0x32, 0x2a, 0x63, 0x29, 0x29, 0x5d, 0x3d, 0x65, 0x5b, 0x30, 0x5d, 0x2c, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x5b, 0x34, 0x2a, 0x66, 0x2b, 0x31, 0x2b, 0x34, 0x2a, 0x28, 0x34, 0x2a, 0x28, 0x31, 0x39, 0x32, 0x2a, 0x63, 0x29, 0x29, 0x5d, 0x3d, 0x65, 0x5b, 0x31, 0x5d, 0x2c, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x5b, 0x34, 0x2a, 0x66, 0x2b, 0x32, 0x2b, 0x34, 0x2a, 0x28, 0x34, 0x2a, 0x28, 0x31, 0x39, 0x32, 0x2a, 0x63, 0x29, 0x29, 0x5d, 0x3d, 0x65, 0x5b, 0x32, 0x5d, 0x2c, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x5b, 0x34, 0x2a, 0x66, 0x2b, 0x33, 0x2b, 0x34, 0x2a, 0x28, 0x34, 0x2a, 0x28, 0x31, 0x39, 0x32, 0x2a, 0x63, 0x29, 0x29, 0x5d, 0x3d, 0x32, 0x35, 0x35, 0x7d, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x64, 0x72, 0x61, 0x77, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x28, 0x29, 0x7b, 0x61, 0x3d, 0x70, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x5b, 0x28, 0x32, 0x34, 0x30, 0x26, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x42, 0x75, 0x66, 0x66, 0x5b, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x43, 0x6f, 0x6c, 0x2b, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x50, 0x61, 0x6c, 0x5d, 0x29, 0x2f, 0x31, 0x36, 0x5d, 0x2c, 0x62, 0x3d, 0x70, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x5b, 0x31, 0x35, 0x26, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x42, 0x75, 0x66, 0x66, 0x5b, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x43, 0x6f, 0x6c, 0x2b, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x50, 0x61, 0x6c, 0x5d, 0x5d, 0x3b, 0x66, 0x6f, 0x72, 0x28, 0x6c, 0x65, 0x74, 0x20, 0x63, 0x3d, 0x30, 0x3b, 0x34, 0x3e, 0x63, 0x3b, 0x63, 0x2b, 0x2b, 0x29, 0x66, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5b, 0x63, 0x5d, 0x3d, 0x61, 0x5b, 0x63, 0x5d, 0x2c, 0x66, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5b, 0x63, 0x2b, 0x34, 0x5d, 0x3d, 0x62, 0x5b, 0x63, 0x5d, 0x3b, 0x66, 0x6f, 0x72, 0x28, 0x6c, 0x65, 0x74, 0x20, 0x64, 0x3d, 0x30, 0x3b, 0x64, 0x3c, 0x33, 0x36, 0x38, 0x36, 0x34, 0x3b, 0x64, 0x2b, 0x2b, 0x29, 0x7b, 0x6c, 0x65, 0x74, 0x20, 0x65, 0x3d, 0x4d, 0x61, 0x74, 0x68, 0x2e, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x28, 0x33, 0x2a, 0x28, 0x4d, 0x61, 0x74, 0x68, 0x2e, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x28, 0x64, 0x2f, 0x34, 0x29, 0x2f, 0x32, 0x29, 0x29, 0x2c, 0x66, 0x3d, 0x32, 0x2a, 0x28, 0x64, 0x25, 0x34, 0x29, 0x2c, 0x67, 0x3d, 0x64, 0x25, 0x38, 0x2c, 0x68, 0x3d, 0x28, 0x33, 0x3c, 0x3c, 0x66, 0x26, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x42, 0x75, 0x66, 0x66, 0x5b, 0x65, 0x5d, 0x29, 0x3e, 0x3e, 0x66, 0x2c, 0x63, 0x3d, 0x28, 0x31, 0x3c, 0x3c, 0x67, 0x26, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x42, 0x75, 0x66, 0x66, 0x5b, 0x65, 0x2b, 0x28, 0x32, 0x2d, 0x65, 0x25, 0x33, 0x29, 0x5d, 0x29, 0x3e, 0x3e, 0x67, 0x2c, 0x69, 0x3d, 0x66, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5b, 0x68, 0x2b, 0x34, 0x2a, 0x63, 0x5d, 0x3b, 0x78, 0x3d, 0x4d, 0x61, 0x74, 0x68, 0x2e, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x28, 0x64, 0x25, 0x31, 0x39, 0x32, 0x29, 0x2c, 0x79, 0x3d, 0x4d, 0x61, 0x74, 0x68, 0x2e, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x28, 0x64, 0x2f, 0x31, 0x39, 0x32, 0x29, 0x2c, 0x64, 0x72, 0x61, 0x77, 0x48, 0x69, 0x52, 0x65, 0x73, 0x50, 0x69, 0x78, 0x65, 0x6c, 0x28, 0x78, 0x2c, 0x79, 0x2c, 0x69, 0x29, 0x7d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x28, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x64, 0x61, 0x74, 0x61, 0x2c, 0x30, 0x2c, 0x30, 0x29, 0x7d, 0x6c, 0x65, 0x74, 0x20, 0x70, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x3d, 0x5b, 0x5b, 0x5b, 0x30, 0x2c, 0x30, 0x2c, 0x30, 0x5d, 0x2c, 0x5b, 0x39, 0x36, 0x2c, 0x39, 0x36, 0x2c, 0x39, 0x36, 0x5d, 0x2c, 0x5b, 0x31, 0x34, 0x34, 0x2c, 0x31, 0x34, 0x34, 0x2c, 0x31, 0x34, 0x34, 0x5d, 0x2c, 0x5b, 0x31, 0x39, 0x32, 0x2c, 0x31, 0x39, 0x32, 0x2c, 0x31, 0x39, 0x32, 0x5d, 0x5d, 0x2c, 0x5b, 0x5b, 0x36, 0x34, 0x2c, 0x36, 0x34, 0x2c, 0x36, 0x34, 0x5d, 0x2c, 0x5b, 0x31, 0x32, 0x38, 0x2c, 0x31, 0x32, 0x38, 0x2c, 0x31, 0x32, 0x38, 0x5d, 0x2c, 0x5b, 0x31, 0x36, 0x30, 0x2c, 0x31, 0x36, 0x30, 0x2c, 0x31, 0x36, 0x30, 0x5d, 0x2c, 0x5b, 0x32, 0x35, 0x35, 0x2c, 0x32, 0x35, 0x35, 0x2c, 0x32, 0x35, 0x35, 0x5d, 0x5d, 0x2c, 0x5b, 0x5b, 0x32, 0x31, 0x2c, 0x33, 0x2c, 0x37, 0x5d, 0x2c, 0x5b, 0x37, 0x34, 0x2c, 0x31, 0x38, 0x2c, 0x32, 0x31, 0x5d, 0x2c, 0x5b, 0x31, 0x37, 0x34, 0x2c, 0x34, 0x38, 0x2c, 0x34, 0x39, 0x5d, 0x2c, 0x5b, 0x32, 0x33, 0x36, 0x2c, 0x31, 0x32, 0x36, 0x2c, 0x31, 0x32, 0x34, 0x5d, 0x5d, 0x2c, 0x5b, 0x5b, 0x34, 0x38, 0x2c, 0x31, 0x31, 0x2c, 0x31, 0x34, 0x5d, 0x2c, 0x5b, 0x31, 0x32, 0x36, 0x2c, 0x33, 0x33, 0x2c, 0x33, 0x35, 0x5d, 0x2c, 0x5b, 0x32, 0x33, 0x30, 0x2c, 0x36, 0x33, 0x2c, 0x36, 0x33, 0x5d, 0x2c, 0x5b, 0x32, 0x34, 0x32, 0x2c, 0x31, 0x38, 0x39, 0x2c, 0x31, 0x38, 0x34, 0x5d, 0x5d, 0x2c, 0x5b, 0x5b, 0x32, 0x32, 0x2c, 0x36, 0x2c, 0x32, 0x5d, 0x2c, 0x5b, 0x37, 0x32, 0x2c, 0x34, 0x34, 0x2c, 0x31, 0x31, 0x5d, 0x2c, 0x5b, 0x32, 0x32, 0x30, 0x2c, 0x31, 0x35, 0x35, 0x2c, 0x33, 0x35, 0x5d, 0x2c, 0x5b, 0x32, 0x33, 0x35, 0x2c, 0x31, 0x38, 0x35, 0x2c, 0x38, 0x31, 0x5d, 0x5d, 0x2c, 0x5b, 0x5b, 0x34, 0x37, 0x2c, 0x32, 0x35, 0x2c, 0x37, 0x5d, 0x2c, 0x5b, 0x31, 0x32, 0x31, 0x2c, 0x38, 0x31, 0x2c, 0x31, 0x39, 0x5d, 0x2c, 0x5b, 0x32, 0x32, 0x38, 0x2c, 0x31, 0x37, 0x30, 0x2c, 0x35, 0x38, 0x5d, 0x2c, 0x5b, 0x32, 0x35, 0x30, 0x2c, 0x32, 0x31, 0x35, 0x2c, 0x31, 0x32, 0x36, 0x5d, 0x5d, 0x2c, 0x5b, 0x5b, 0x32, 0x35, 0x2c, 0x32, 0x31, 0x2c, 0x30, 0x5d, 0x2c, 0x5b, 0x31, 0x35, 0x35, 0x2c, 0x39, 0x30, 0x2c, 0x31, 0x36, 0x5d, 0x2c, 0x5b, 0x32, 0x31, 0x30, 0x2c, 0x31, 0x31, 0x38, 0x2c, 0x32, 0x30, 0x5d, 0x2c, 0x5b, 0x32, 0x34, 0x32, 0x2c, 0x31, 0x37, 0x38, 0x2c, 0x31, 0x32, 0x33, 0x5d, 0x5d, 0x2c, 0x5b, 0x5b, 0x32, 0x2c, 0x32, 0x34, 0x2c, 0x31, 0x32, 0x5d, 0x2c, 0x5b, 0x37, 0x34, 0x2c, 0x31, 0x30, 0x32, 0x2c, 0x36, 0x31, 0x5d, 0x2c, 0x5b, 0x31, 0x32, 0x34, 0x2c, 0x31, 0x35, 0x33, 0x2c, 0x31, 0x30, 0x30, 0x5d, 0x2c, 0x5b, 0x31, 0x37, 0x38, 0x2c, 0x32, 0x30, 0x33, 0x2c, 0x31, 0x34, 0x34, 0x5d, 0x5d, 0x2c, 0x5b, 0x5b, 0x35, 0x30, 0x2c, 0x37, 0x36, 0x2c, 0x34, 0x35, 0x5d, 0x2c, 0x5b, 0x39, 0x37, 0x2c, 0x31, 0x32, 0x38, 0x2c, 0x37, 0x37, 0x5d, 0x2c, 0x5b, 0x31, 0x35, 0x31, 0x2c, 0x31, 0x37, 0x38, 0x2c, 0x31, 0x32, 0x32, 0x5d, 0x2c, 0x5b, 0x32, 0x30, 0x35, 0x2c, 0x32, 0x32, 0x37, 0x2c, 0x31, 0x36, 0x36, 0x5d, 0x5d, 0x2c, 0x5b, 0x5b, 0x37, 0x2c, 0x31, 0x33, 0x2c, 0x31, 0x37, 0x5d, 0x2c, 0x5b, 0x35, 0x38, 0x2c, 0x31, 0x31, 0x39, 0x2c, 0x31, 0x32, 0x36, 0x5d, 0x2c, 0x5b, 0x31, 0x30, 0x38, 0x2c, 0x32, 0x32, 0x35, 0x2c, 0x32, 0x33, 0x34, 0x5d, 0x2c, 0x5b, 0x31, 0x34, 0x38, 0x2c, 0x32, 0x33, 0x32, 0x2c, 0x32, 0x33, 0x30, 0x5d, 0x5d, 0x2c, 0x5b, 0x5b, 0x33, 0x33, 0x2c, 0x36, 0x36, 0x2c, 0x37, 0x32, 0x5d, 0x2c, 0x5b, 0x38, 0x33, 0x2c, 0x31, 0x37, 0x32, 0x2c, 0x31, 0x38, 0x30, 0x5d, 0x2c, 0x5b, 0x31, 0x32, 0x38, 0x2c, 0x32, 0x32, 0x39, 0x2c, 0x32, 0x33, 0x32, 0x5d, 0x2c, 0x5b, 0x31, 0x38, 0x38, 0x2c, 0x32, 0x33, 0x38, 0x2c, 0x32, 0x32, 0x36, 0x5d, 0x5d, 0x2c, 0x5b, 0x5b, 0x37, 0x2c, 0x31, 0x2c, 0x32, 0x36, 0x5d, 0x2c, 0x5b, 0x32, 0x31, 0x2c, 0x33, 0x34, 0x2c, 0x38, 0x33, 0x5d, 0x2c, 0x5b, 0x33, 0x35, 0x2c, 0x36, 0x36, 0x2c, 0x31, 0x36, 0x35, 0x5d, 0x2c, 0x5b, 0x35, 0x39, 0x2c, 0x31, 0x30, 0x34, 0x2c, 0x31, 0x39, 0x31, 0x5d, 0x5d, 0x2c, 0x5b, 0x5b, 0x31, 0x34, 0x2c, 0x31, 0x38, 0x2c, 0x35, 0x35, 0x5d, 0x2c, 0x5b, 0x32, 0x38, 0x2c, 0x35, 0x30, 0x2c, 0x31, 0x31, 0x31, 0x5d, 0x2c, 0x5b, 0x34, 0x37, 0x2c, 0x38, 0x35, 0x2c, 0x31, 0x36, 0x35, 0x5d, 0x2c, 0x5b, 0x38, 0x32, 0x2c, 0x31, 0x34, 0x31, 0x2c, 0x32, 0x34, 0x32, 0x5d, 0x5d, 0x2c, 0x5b, 0x5b, 0x32, 0x31, 0x2c, 0x33, 0x34, 0x2c, 0x38, 0x33, 0x5d, 0x2c, 0x5b, 0x37, 0x30, 0x2c, 0x31, 0x33, 0x35, 0x2c, 0x31, 0x34, 0x33, 0x5d, 0x2c, 0x5b, 0x31, 0x34, 0x38, 0x2c, 0x32, 0x32, 0x37, 0x2c, 0x36, 0x38, 0x5d, 0x2c, 0x5b, 0x32, 0x32, 0x36, 0x2c, 0x32, 0x34, 0x33, 0x2c, 0x32, 0x32, 0x38, 0x5d, 0x5d, 0x2c, 0x5b, 0x5b, 0x30, 0x2c, 0x34, 0x38, 0x2c, 0x35, 0x39, 0x5d, 0x2c, 0x5b, 0x32, 0x35, 0x35, 0x2c, 0x31, 0x31, 0x39, 0x2c, 0x31, 0x31, 0x39, 0x5d, 0x2c, 0x5b, 0x32, 0x35, 0x35, 0x2c, 0x32, 0x30, 0x36, 0x2c, 0x31, 0x35, 0x30, 0x5d, 0x2c, 0x5b, 0x32, 0x34, 0x31, 0x2c, 0x32, 0x34, 0x32, 0x2c, 0x32, 0x31, 0x38, 0x5d, 0x5d, 0x2c, 0x5b, 0x5b, 0x30, 0x2c, 0x30, 0x2c, 0x30, 0x5d, 0x2c, 0x5b, 0x31, 0x39, 0x37, 0x2c, 0x31, 0x37, 0x2c, 0x31, 0x37, 0x5d, 0x2c, 0x5b, 0x32, 0x30, 0x2c, 0x35, 0x38, 0x2c, 0x31, 0x33, 0x33, 0x5d, 0x2c, 0x5b, 0x32, 0x35, 0x35, 0x2c, 0x32, 0x35, 0x35, 0x2c, 0x32, 0x35, 0x35, 0x5d, 0x5d, 0x5d, 0x3b}
+var drawTemplate=[0x265]byte{0x76, 0x61, 0x72, 0x20, 0x63, 0x61, 0x6e, 0x76, 0x61, 0x73, 0x3d, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x49, 0x64, 0x28, 0x22, 0x67, 0x6f, 0x6c, 0x66, 0x63, 0x61, 0x6e, 0x76, 0x61, 0x73, 0x22, 0x29, 0x2c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x3d, 0x63, 0x61, 0x6e, 0x76, 0x61, 0x73, 0x2e, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x28, 0x22, 0x32, 0x64, 0x22, 0x29, 0x2c, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x43, 0x61, 0x6e, 0x76, 0x61, 0x73, 0x3d, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x28, 0x22, 0x63, 0x61, 0x6e, 0x76, 0x61, 0x73, 0x22, 0x29, 0x2c, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x3d, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x43, 0x61, 0x6e, 0x76, 0x61, 0x73, 0x2e, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x28, 0x22, 0x32, 0x64, 0x22, 0x29, 0x2c, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x64, 0x61, 0x74, 0x61, 0x2c, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x42, 0x75, 0x66, 0x66, 0x3b, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x69, 0x6e, 0x69, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x28, 0x61, 0x2c, 0x62, 0x29, 0x7b, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x43, 0x61, 0x6e, 0x76, 0x61, 0x73, 0x2e, 0x77, 0x69, 0x64, 0x74, 0x68, 0x3d, 0x61, 0x2c, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x43, 0x61, 0x6e, 0x76, 0x61, 0x73, 0x2e, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x3d, 0x62, 0x2c, 0x63, 0x61, 0x6e, 0x76, 0x61, 0x73, 0x2e, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x3d, 0x63, 0x61, 0x6e, 0x76, 0x61, 0x73, 0x2e, 0x77, 0x69, 0x64, 0x74, 0x68, 0x2a, 0x62, 0x2f, 0x61, 0x2c, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x64, 0x61, 0x74, 0x61, 0x3d, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x28, 0x61, 0x2c, 0x62, 0x29, 0x2c, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x42, 0x75, 0x66, 0x66, 0x3d, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x7d, 0x63, 0x61, 0x6e, 0x76, 0x61, 0x73, 0x2e, 0x61, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x28, 0x22, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x6d, 0x65, 0x6e, 0x75, 0x22, 0x2c, 0x61, 0x3d, 0x3e, 0x61, 0x2e, 0x70, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x28, 0x29, 0x29, 0x3b, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x64, 0x72, 0x61, 0x77, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x28, 0x61, 0x2c, 0x62, 0x29, 0x7b, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x75, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x28, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x64, 0x61, 0x74, 0x61, 0x2c, 0x30, 0x2c, 0x30, 0x2c, 0x30, 0x2c, 0x61, 0x2c, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x43, 0x61, 0x6e, 0x76, 0x61, 0x73, 0x2e, 0x77, 0x69, 0x64, 0x74, 0x68, 0x2c, 0x62, 0x2d, 0x61, 0x29, 0x2c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x6d, 0x6f, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x3d, 0x21, 0x31, 0x2c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x64, 0x72, 0x61, 0x77, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x28, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x43, 0x61, 0x6e, 0x76, 0x61, 0x73, 0x2c, 0x30, 0x2c, 0x30, 0x2c, 0x63, 0x61, 0x6e, 0x76, 0x61, 0x73, 0x2e, 0x77, 0x69, 0x64, 0x74, 0x68, 0x2c, 0x63, 0x61, 0x6e, 0x76, 0x61, 0x73, 0x2e, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x29, 0x7d}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"os/exec"
 	"runtime"
+	"strconv"
 	"strings"
 )
 
@@ -137,6 +138,20 @@ var commands = []command{
 		},
 	},
 
+	command{
+		"font",
+		"font <font file> <char file> <output file> <row>",
+		"<font file> <char file> <output file> <row> converts a bdf font or a png glyph grid into golf font data that is loaded into the sprite sheet starting at pixel row <row>",
+		4,
+		func(args []string) error {
+			row, err := strconv.Atoi(args[3])
+			if err != nil {
+				return fmt.Errorf("%s is not a sprite sheet row", args[3])
+			}
+			return convertFont(args[0], args[1], args[2], row)
+		},
+	},
+
 	command{
 		"startserver",
 		"startserver",
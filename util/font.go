@@ -0,0 +1,384 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"go/format"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// fontGlyph is a single imported glyph, pixels is true where the glyph is drawn
+type fontGlyph struct {
+	char   rune
+	width  int
+	pixels [][]bool
+}
+
+// importedFont is a font read from a bdf file or a png glyph grid
+type importedFont struct {
+	w, h   int
+	glyphs []fontGlyph
+}
+
+// convertFont converts a bdf font or a png glyph grid into golf font data that is
+// loaded into the sprite sheet starting at pixel row row. For a bdf font the char file
+// lists the characters to import. For a png the char file lists the characters in the
+// grid, one line of the file for each row
+func convertFont(fontFile, charFile, outputFile string, row int) error {
+	chars, err := ioutil.ReadFile(charFile)
+	if err != nil {
+		return err
+	}
+	lines := strings.Split(strings.Replace(string(chars), "\r", "", -1), "\n")
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 0 {
+		return errors.New("the char file is empty")
+	}
+
+	var font importedFont
+	switch strings.ToLower(filepath.Ext(fontFile)) {
+	case ".bdf":
+		font, err = readBDF(fontFile, []rune(strings.Join(lines, "")))
+	case ".png":
+		font, err = readGlyphGrid(fontFile, lines)
+	default:
+		err = fmt.Errorf("%s is not a bdf or png file", fontFile)
+	}
+	if err != nil {
+		return err
+	}
+
+	data, err := font.pack(row)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(outputFile, []byte(font.goFile(fontName(outputFile), row, data)), 0644)
+}
+
+// readBDF reads the glyphs for chars from a bdf font file
+func readBDF(fontFile string, chars []rune) (importedFont, error) {
+	file, err := os.Open(fontFile)
+	if err != nil {
+		return importedFont{}, err
+	}
+	defer file.Close()
+
+	font := importedFont{}
+	boxX, boxY := 0, 0
+
+	// the DWIDTH of the glyphs that don't have their own
+	fontWidth := 0
+	all := map[rune]fontGlyph{}
+
+	// the glyph that is being read
+	var glyph fontGlyph
+	var bbx [4]int
+	char, bitmap, inChar := rune(-1), false, false
+
+	ints := func(fields []string, n int) ([]int, error) {
+		if len(fields) < n+1 {
+			return nil, fmt.Errorf("%s is missing values", fields[0])
+		}
+		ret := []int{}
+		for _, f := range fields[1 : n+1] {
+			i, err := strconv.Atoi(f)
+			if err != nil {
+				return nil, fmt.Errorf("bad %s value %s", fields[0], f)
+			}
+			ret = append(ret, i)
+		}
+		return ret, nil
+	}
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if bitmap && fields[0] != "ENDCHAR" {
+			row, err := strconv.ParseUint(fields[0], 16, 64)
+			if err != nil {
+				return font, fmt.Errorf("bad bitmap row %s", fields[0])
+			}
+			bits := len(fields[0]) * 4
+			pixels := make([]bool, bbx[0])
+			for x := range pixels {
+				pixels[x] = row&(1<<uint(bits-1-x)) > 0
+			}
+			glyph.pixels = append(glyph.pixels, pixels)
+			continue
+		}
+
+		switch fields[0] {
+		case "FONTBOUNDINGBOX":
+			v, err := ints(fields, 4)
+			if err != nil {
+				return font, err
+			}
+			font.w, font.h, boxX, boxY = v[0], v[1], v[2], v[3]
+		case "STARTCHAR":
+			glyph, bbx, char, inChar = fontGlyph{}, [4]int{}, -1, true
+		case "ENCODING":
+			v, err := ints(fields, 1)
+			if err != nil {
+				return font, err
+			}
+			char = rune(v[0])
+		case "DWIDTH":
+			v, err := ints(fields, 1)
+			if err != nil {
+				return font, err
+			}
+			if inChar {
+				glyph.width = v[0]
+			} else {
+				fontWidth = v[0]
+			}
+		case "BBX":
+			v, err := ints(fields, 4)
+			if err != nil {
+				return font, err
+			}
+			copy(bbx[:], v)
+		case "BITMAP":
+			bitmap = true
+		case "ENDCHAR":
+			bitmap, inChar = false, false
+			if char < 0 {
+				continue
+			}
+
+			// glyphs without a DWIDTH use the font DWIDTH, then the right edge of their
+			// bounding box with a 1 pixel gap. Blank glyphs are as wide as the font
+			if glyph.width <= 0 {
+				glyph.width = fontWidth
+			}
+			if glyph.width <= 0 && bbx[0] > 0 {
+				glyph.width = bbx[0] + bbx[2] - boxX + 1
+			}
+			if glyph.width <= 0 {
+				glyph.width = font.w
+			}
+
+			// move the glyph into a cell the size of the font bounding box
+			cell := newCell(font.w, font.h)
+			top := (font.h + boxY) - (bbx[1] + bbx[3])
+			for y, row := range glyph.pixels {
+				for x, set := range row {
+					cx, cy := x+bbx[2]-boxX, y+top
+					if cx >= 0 && cx < font.w && cy >= 0 && cy < font.h {
+						cell[cy][cx] = set
+					}
+				}
+			}
+			glyph.char, glyph.pixels = char, cell
+			all[char] = glyph
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return font, err
+	}
+	if font.w <= 0 || font.h <= 0 {
+		return font, errors.New("the bdf file has no FONTBOUNDINGBOX")
+	}
+
+	for _, c := range chars {
+		glyph, ok := all[c]
+		if !ok {
+			return font, fmt.Errorf("the font has no glyph for %q", c)
+		}
+		font.glyphs = append(font.glyphs, glyph)
+	}
+	return font, nil
+}
+
+// readGlyphGrid reads the glyphs from a png grid. Each line of chars is a row of the grid
+// and the size of the cells is worked out from the size of the image. Transparent pixels
+// and pixels that are the most common color in the image are the background of the glyphs
+func readGlyphGrid(fontFile string, lines []string) (importedFont, error) {
+	file, err := os.Open(fontFile)
+	if err != nil {
+		return importedFont{}, err
+	}
+	defer file.Close()
+
+	img, err := png.Decode(file)
+	if err != nil {
+		return importedFont{}, err
+	}
+
+	cols := 0
+	for _, line := range lines {
+		if n := len([]rune(line)); n > cols {
+			cols = n
+		}
+	}
+	b := img.Bounds()
+	if b.Dx()%cols != 0 || b.Dy()%len(lines) != 0 {
+		return importedFont{}, fmt.Errorf("a %dx%d image can't be split into %d columns and %d rows of glyphs", b.Dx(), b.Dy(), cols, len(lines))
+	}
+
+	font := importedFont{w: b.Dx() / cols, h: b.Dy() / len(lines)}
+	count := map[[4]uint32]int{}
+	bg := [4]uint32{}
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			r, g, bl, a := img.At(x, y).RGBA()
+			c := [4]uint32{r, g, bl, a}
+			count[c]++
+			if count[c] > count[bg] {
+				bg = c
+			}
+		}
+	}
+	ink := func(x, y int) bool {
+		r, g, bl, a := img.At(x, y).RGBA()
+		return a > 0x7FFF && [4]uint32{r, g, bl, a} != bg
+	}
+
+	for row, line := range lines {
+		for col, c := range []rune(line) {
+			glyph := fontGlyph{char: c, pixels: newCell(font.w, font.h)}
+			right := -1
+			for y := 0; y < font.h; y++ {
+				for x := 0; x < font.w; x++ {
+					if ink(b.Min.X+col*font.w+x, b.Min.Y+row*font.h+y) {
+						glyph.pixels[y][x] = true
+						if x > right {
+							right = x
+						}
+					}
+				}
+			}
+
+			// leave a 1 pixel gap after each glyph, blank glyphs (e.g. space) are half a cell wide
+			glyph.width = right + 2
+			if right < 0 {
+				glyph.width = (font.w + 1) / 2
+			}
+			font.glyphs = append(font.glyphs, glyph)
+		}
+	}
+	return font, nil
+}
+
+// newCell creates a blank w x h glyph cell
+func newCell(w, h int) [][]bool {
+	ret := make([][]bool, h)
+	for y := range ret {
+		ret[y] = make([]bool, w)
+	}
+	return ret
+}
+
+// pack lays the glyphs out left to right in sprite sheet rows and packs them into
+// the golf pixel format. Glyph pixels are Col7 and the background is Col0.
+// row is the sprite sheet pixel row the glyphs will be loaded into
+func (f *importedFont) pack(row int) ([]byte, error) {
+	cols := 256 / f.w
+	if cols < 1 {
+		return nil, fmt.Errorf("%d pixel wide glyphs don't fit on the sprite sheet", f.w)
+	}
+	if row < 0 || row > 127 {
+		return nil, fmt.Errorf("row %d is not on the sprite sheet, it must be 0-127", row)
+	}
+	rows := (len(f.glyphs) + cols - 1) / cols
+	if row+rows*f.h > 128 {
+		return nil, fmt.Errorf("%d %dx%d glyphs don't fit on the sprite sheet starting at row %d", len(f.glyphs), f.w, f.h, row)
+	}
+
+	ret := make([]byte, rows*f.h*256/8*3)
+	for i, glyph := range f.glyphs {
+		gx, gy := i%cols*f.w, i/cols*f.h
+		for y, row := range glyph.pixels {
+			for x, set := range row {
+				if !set {
+					continue
+				}
+				p := gx + x + (gy+y)*256
+				ret[p/8*3+(p/4)%2] |= 0b00000011 << uint((p%4)*2)
+				ret[p/8*3+2] |= 0b00000001 << uint(p%8)
+			}
+		}
+	}
+	return ret, nil
+}
+
+// goFile writes the font as a golf.Font named name. row is the sprite
+// sheet row the glyphs are loaded into and data is the packed glyphs
+func (f *importedFont) goFile(name string, row int, data []byte) string {
+	chars, widths := "", []string{}
+	for _, glyph := range f.glyphs {
+		chars += string(glyph.char)
+		widths = append(widths, strconv.Itoa(glyph.width))
+	}
+
+	// 16 bytes on each line of the data
+	lines := []string{}
+	for i := 0; i < len(data); i += 16 {
+		end := i + 16
+		if end > len(data) {
+			end = len(data)
+		}
+		bytes := []string{}
+		for _, b := range data[i:end] {
+			bytes = append(bytes, fmt.Sprintf("0x%02X", b))
+		}
+		lines = append(lines, "\t\t"+strings.Join(bytes, ", ")+",\n")
+	}
+
+	content := "package main\n\nimport \"github.com/bjatkin/golf-engine/golf\"\n\n"
+	content += "// " + name + " is a font generated by the golf toolkit, add it to the engine with AddFont.\n"
+	content += fmt.Sprintf("// The glyphs are copied into the sprite sheet starting at row %d, overwriting the sprites there\n", row)
+	content += "var " + name + " = golf.Font{\n"
+	content += fmt.Sprintf("\tChars: %q,\n", chars)
+	content += "\tX: 0,\n"
+	content += fmt.Sprintf("\tY: %d,\n", row)
+	content += fmt.Sprintf("\tW: %d,\n", f.w)
+	content += fmt.Sprintf("\tH: %d,\n", f.h)
+	content += "\tWidths: []int{" + strings.Join(widths, ", ") + "},\n"
+	content += fmt.Sprintf("\tLineH: %d,\n", f.h)
+	content += "\tData: []byte{\n" + strings.Join(lines, "") + "\t},\n"
+	content += "}\n"
+
+	// gofmt lines up the field values
+	if formatted, err := format.Source([]byte(content)); err == nil {
+		return string(formatted)
+	}
+	return content
+}
+
+// fontName makes a go variable name from the output file name
+func fontName(outputFile string) string {
+	base := strings.TrimSuffix(filepath.Base(outputFile), filepath.Ext(outputFile))
+	name, upper := "", false
+	for _, r := range base {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = name != ""
+			continue
+		}
+		if name == "" && unicode.IsDigit(r) {
+			name = "font"
+			upper = true
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		name += string(r)
+	}
+	if name == "" {
+		return "font"
+	}
+	return name
+}
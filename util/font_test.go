@@ -0,0 +1,263 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"image"
+	"image/color"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bjatkin/golf-engine/golf"
+)
+
+// testBDF is a 4x4 bdf font with the glyphs 'A' and 'B'
+const testBDF = `STARTFONT 2.1
+FONTBOUNDINGBOX 4 4 0 0
+STARTCHAR A
+ENCODING 65
+DWIDTH 4 0
+BBX 4 4 0 0
+BITMAP
+60
+90
+F0
+90
+ENDCHAR
+STARTCHAR B
+ENCODING 66
+DWIDTH 3 0
+BBX 3 4 0 0
+BITMAP
+C0
+E0
+A0
+E0
+ENDCHAR
+ENDFONT
+`
+
+func TestConvertFont(t *testing.T) {
+	dir, err := ioutil.TempDir("", "golf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fontFile, charFile := filepath.Join(dir, "test.bdf"), filepath.Join(dir, "chars.txt")
+	outputFile := filepath.Join(dir, "test_font.go")
+	writeFile(t, fontFile, testBDF)
+	writeFile(t, charFile, "AB\n")
+
+	if err := convertFont(fontFile, charFile, outputFile, 40); err != nil {
+		t.Fatal(err)
+	}
+	out, err := ioutil.ReadFile(outputFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	formatted, err := format.Source(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(formatted) != string(out) {
+		t.Errorf("the font file isn't gofmt clean:\n%s", out)
+	}
+	fields := strings.Join(strings.Fields(string(out)), " ")
+	for _, want := range []string{"var testFont = golf.Font{", `Chars: "AB",`, "Y: 40,", "W: 4,", "H: 4,", "Widths: []int{4, 3},", "LineH: 4,"} {
+		if !strings.Contains(fields, want) {
+			t.Errorf("the font file is missing %q:\n%s", want, out)
+		}
+	}
+
+	// the glyphs must fit on the sprite sheet below the row
+	for _, row := range []int{-1, 125, 128} {
+		if err := convertFont(fontFile, charFile, outputFile, row); err == nil {
+			t.Errorf("row %d should not fit on the sprite sheet", row)
+		}
+	}
+}
+
+// bdfGlyphs is the start of a 4x4 bdf font, header is added after the FONTBOUNDINGBOX and
+// the glyphs 'A', 'B' and ' ' follow without a DWIDTH, with a 4, 3 and 0 pixel wide BBX
+func bdfGlyphs(header string) string {
+	return `STARTFONT 2.1
+FONTBOUNDINGBOX 4 4 0 0
+` + header + `STARTCHAR A
+ENCODING 65
+BBX 4 4 0 0
+BITMAP
+60
+90
+F0
+90
+ENDCHAR
+STARTCHAR B
+ENCODING 66
+BBX 3 4 0 0
+BITMAP
+C0
+E0
+A0
+E0
+ENDCHAR
+STARTCHAR space
+ENCODING 32
+BBX 0 0 0 0
+BITMAP
+ENDCHAR
+ENDFONT
+`
+}
+
+func TestReadBDFWidths(t *testing.T) {
+	dir, err := ioutil.TempDir("", "golf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cases := []struct {
+		header string
+		widths []int
+	}{
+		// the font DWIDTH is used for every glyph
+		{"DWIDTH 6 0\n", []int{6, 6, 6}},
+		// without a DWIDTH the bounding box is used, blank glyphs are as wide as the font
+		{"", []int{5, 4, 4}},
+	}
+	fontFile := filepath.Join(dir, "test.bdf")
+	for _, c := range cases {
+		writeFile(t, fontFile, bdfGlyphs(c.header))
+		font, err := readBDF(fontFile, []rune("AB "))
+		if err != nil {
+			t.Fatal(err)
+		}
+		widths := []int{}
+		for _, glyph := range font.glyphs {
+			widths = append(widths, glyph.width)
+		}
+		if fmt.Sprint(widths) != fmt.Sprint(c.widths) {
+			t.Errorf("header %q: got widths %v, want %v", c.header, widths, c.widths)
+		}
+	}
+}
+
+// testGrid is a 3x2 grid of 4x4 glyphs, # is ink and . is the background
+var testGrid = []string{
+	"###.#...........",
+	"#.#.#...........",
+	"###.#....#......",
+	"#.#.#...........",
+	"##..##..........",
+	"#.#.#.#.........",
+	"##..##..........",
+	"#...#...........",
+}
+
+func TestReadGlyphGrid(t *testing.T) {
+	dir, err := ioutil.TempDir("", "golf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// the background is the most common color, transparent pixels are never ink
+	img := image.NewNRGBA(image.Rect(0, 0, 16, 8))
+	for y, line := range testGrid {
+		for x, c := range line {
+			img.Set(x, y, color.NRGBA{255, 255, 255, 255})
+			if c == '#' {
+				img.Set(x, y, color.NRGBA{0, 0, 0, 255})
+			}
+		}
+	}
+	img.Set(15, 7, color.NRGBA{0, 0, 0, 0})
+	buf := &bytes.Buffer{}
+	if err := png.Encode(buf, img); err != nil {
+		t.Fatal(err)
+	}
+	fontFile := filepath.Join(dir, "test.png")
+	writeFile(t, fontFile, buf.String())
+
+	font, err := readGlyphGrid(fontFile, []string{"AI. ", "BP"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if font.w != 4 || font.h != 4 {
+		t.Fatalf("the glyphs are %dx%d, want 4x4", font.w, font.h)
+	}
+	// a 1 pixel gap is left after the ink, blank glyphs are half a cell wide
+	want := []struct {
+		char  rune
+		width int
+	}{{'A', 4}, {'I', 2}, {'.', 3}, {' ', 2}, {'B', 4}, {'P', 4}}
+	if len(font.glyphs) != len(want) {
+		t.Fatalf("got %d glyphs, want %d", len(font.glyphs), len(want))
+	}
+	for i, glyph := range font.glyphs {
+		if glyph.char != want[i].char || glyph.width != want[i].width {
+			t.Errorf("glyph %d is %q %d wide, want %q %d wide", i, glyph.char, glyph.width, want[i].char, want[i].width)
+		}
+		for y, row := range glyph.pixels {
+			for x, set := range row {
+				if ink := testGrid[i/4*4+y][i%4*4+x] == '#'; set != ink {
+					t.Errorf("glyph %q pixel %d, %d is %v, want %v", glyph.char, x, y, set, ink)
+				}
+			}
+		}
+	}
+
+	if _, err := readGlyphGrid(fontFile, []string{"ABCDE"}); err == nil {
+		t.Error("a 16 pixel wide image shouldn't split into 5 columns")
+	}
+}
+
+func TestPack(t *testing.T) {
+	// 3 glyphs that are 90 pixels wide, so the last glyph wraps onto a second row of glyphs
+	font := importedFont{w: 90, h: 3}
+	for i := 0; i < 3; i++ {
+		glyph := fontGlyph{char: rune('a' + i), pixels: newCell(font.w, font.h)}
+		for y := range glyph.pixels {
+			for x := range glyph.pixels[y] {
+				glyph.pixels[y][x] = (x*7+y*3+i)%5 == 0
+			}
+		}
+		font.glyphs = append(font.glyphs, glyph)
+	}
+	row := 50
+	data, err := font.pack(row)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != 2*font.h*256/8*3 {
+		t.Fatalf("got %d bytes, want 2 rows of glyphs", len(data))
+	}
+
+	// load the data into the engine and draw each glyph on its own line to read back the pixels
+	e := golf.NewEngine(func() {}, func() {}, golf.EOp{Backend: golf.NewHeadless()})
+	f := e.AddFont(golf.Font{Chars: "abc", Y: row, W: font.w, H: font.h, Data: data})
+	e.Cls(golf.Col2)
+	e.Text(0, 0, "a\nb\nc", golf.TOp{Font: f, Fixed: true})
+	for i, glyph := range font.glyphs {
+		for y, row := range glyph.pixels {
+			for x, set := range row {
+				if ink := e.Pget(float64(x), float64(i*font.h+y)) == golf.Col7; ink != set {
+					t.Fatalf("glyph %q pixel %d, %d is %v when it's drawn, want %v", glyph.char, x, y, ink, set)
+				}
+			}
+		}
+	}
+}
+
+// writeFile writes a test file and fails the test if it can't be written
+func writeFile(t *testing.T, name, data string) {
+	if err := ioutil.WriteFile(name, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+}